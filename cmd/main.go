@@ -1,11 +1,21 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
 
+	"github.com/kevensen/go-random-number-mcp/internal/httpserver"
 	"github.com/kevensen/go-random-number-mcp/internal/random"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -15,18 +25,268 @@ const (
 	serverVersion = "0.1.0"
 )
 
+const (
+	transportHTTP  = "http"
+	transportSSE   = "sse"
+	transportStdio = "stdio"
+)
+
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+// authTokenEnv names the environment variable consulted when --auth-token is not given.
+const authTokenEnv = "MCP_AUTH_TOKEN"
+
+// requestIDHeader carries a client-supplied ID that is logged as request_id.
+const requestIDHeader = "X-Request-ID"
+
+// defaultMaxBodyBytes bounds /mcp request bodies unless overridden with --max-body-bytes.
+const defaultMaxBodyBytes = 1 << 20
+
+// gzipMinBytes is the smallest /mcp response body worth compressing for gzip-accepting clients.
+const gzipMinBytes = 1024
+
+// defaultHandlerTimeout bounds each tool call unless overridden with --handler-timeout.
+const defaultHandlerTimeout = 30 * time.Second
+
+// shutdownTimeout bounds how long in-flight HTTP requests may run after SIGINT or SIGTERM.
+const shutdownTimeout = 10 * time.Second
+
+// rateLimitCleanupInterval is how often idle rate limiter buckets are evicted.
+const rateLimitCleanupInterval = time.Minute
+
+type options struct {
+	transport    string
+	listenAddr   string
+	listenPort   int
+	rateLimit    float64
+	maxBodyBytes int64
+	authToken    string
+	tlsCert      string
+	tlsKey       string
+	logFormat    string
+	logLevel     slog.Level
+	// handlerTimeout bounds each tool call; zero disables the limit.
+	handlerTimeout time.Duration
+	// maxConcurrentHeavy bounds concurrent CPU-intensive tool calls; zero disables the limit.
+	maxConcurrentHeavy int
+}
+
+// parseFlags parses command-line arguments into options and validates them.
+func parseFlags(args []string) (*options, error) {
+	opts := &options{}
+	fs := flag.NewFlagSet(serverName, flag.ContinueOnError)
+	fs.StringVar(&opts.listenAddr, "addr", "127.0.0.1", "Listen address")
+	fs.IntVar(&opts.listenPort, "port", 6767, "Listen port")
+	fs.StringVar(&opts.transport, "transport", transportHTTP, "Transport to serve: http, sse, or stdio")
+	fs.Float64Var(&opts.rateLimit, "rate-limit", 0, "Requests per second allowed per remote IP on the HTTP transport (0 = unlimited)")
+	fs.Int64Var(&opts.maxBodyBytes, "max-body-bytes", defaultMaxBodyBytes, "Largest /mcp request body accepted over HTTP, in bytes; larger requests get 413 (0 = unlimited)")
+	fs.StringVar(&opts.authToken, "auth-token", os.Getenv(authTokenEnv), "Bearer token required on /mcp requests over HTTP (defaults to $"+authTokenEnv+")")
+	fs.StringVar(&opts.tlsCert, "tls-cert", "", "PEM certificate file; serves HTTPS when set together with --tls-key")
+	fs.StringVar(&opts.tlsKey, "tls-key", "", "PEM private key file; serves HTTPS when set together with --tls-cert")
+	fs.StringVar(&opts.logFormat, "log-format", logFormatText, "Log output format: text or json")
+	fs.DurationVar(&opts.handlerTimeout, "handler-timeout", defaultHandlerTimeout, "Longest a single tool call may run before it fails (0 = unlimited)")
+	fs.IntVar(&opts.maxConcurrentHeavy, "max-concurrent-heavy", 0, "Most CPU-intensive tool calls, such as random_prime, allowed to run at once; extra calls fail as busy (0 = unlimited)")
+	logLevel := fs.String("log-level", "info", "Minimum log level: debug, info, warn, or error")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := opts.logLevel.UnmarshalText([]byte(*logLevel)); err != nil {
+		return nil, fmt.Errorf("unsupported log level %q: must be debug, info, warn, or error", *logLevel)
+	}
+	if opts.logFormat != logFormatText && opts.logFormat != logFormatJSON {
+		return nil, fmt.Errorf("unsupported log format %q: must be %s or %s", opts.logFormat, logFormatText, logFormatJSON)
+	}
+	if opts.rateLimit < 0 {
+		return nil, fmt.Errorf("rate-limit cannot be negative")
+	}
+	if opts.maxBodyBytes < 0 {
+		return nil, fmt.Errorf("max-body-bytes cannot be negative")
+	}
+	if opts.handlerTimeout < 0 {
+		return nil, fmt.Errorf("handler-timeout cannot be negative")
+	}
+	if opts.maxConcurrentHeavy < 0 {
+		return nil, fmt.Errorf("max-concurrent-heavy cannot be negative")
+	}
+	if (opts.tlsCert == "") != (opts.tlsKey == "") {
+		return nil, fmt.Errorf("tls-cert and tls-key must be set together")
+	}
+
+	switch opts.transport {
+	case transportHTTP, transportStdio:
+	case transportSSE:
+		if opts.useTLS() {
+			return nil, fmt.Errorf("tls-cert and tls-key are not supported with the %s transport", transportSSE)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported transport %q: must be %s, %s, or %s", opts.transport, transportHTTP, transportSSE, transportStdio)
+	}
+	return opts, nil
+}
+
+// useTLS reports whether the HTTP transport should be served over HTTPS.
+func (o *options) useTLS() bool {
+	return o.tlsCert != "" && o.tlsKey != ""
+}
+
+// newLogger returns a logger that writes records at or above level to w in the given format.
+func newLogger(w io.Writer, format string, level slog.Level) *slog.Logger {
+	handlerOpts := &slog.HandlerOptions{Level: level}
+	if format == logFormatJSON {
+		return slog.New(random.NewRequestIDHandler(slog.NewJSONHandler(w, handlerOpts)))
+	}
+	return slog.New(random.NewRequestIDHandler(slog.NewTextHandler(w, handlerOpts)))
+}
+
+// requestIDFromHeader forwards the client's X-Request-ID header, when present, so it
+// appears as request_id in the handler logs.
+func requestIDFromHeader(ctx context.Context, r *http.Request) context.Context {
+	if id := r.Header.Get(requestIDHeader); id != "" {
+		return random.WithRequestID(ctx, id)
+	}
+	return ctx
+}
+
+// httpTransport is an MCP transport served over HTTP. Both the streamable HTTP and the
+// SSE servers from mcp-go satisfy it.
+type httpTransport interface {
+	Start(addr string) error
+	Shutdown(ctx context.Context) error
+}
+
+// newHTTPTransport builds the transport selected by opts on top of httpServer and mounts its
+// endpoints on mux, each wrapped by protect. It returns the transport and the URL that
+// clients connect to.
+func newHTTPTransport(opts *options, mcpServer *server.MCPServer, httpServer *http.Server, mux *http.ServeMux, protect func(http.Handler) http.Handler) (httpTransport, string) {
+	addr := fmt.Sprintf("%s:%d", opts.listenAddr, opts.listenPort)
+	if opts.transport == transportSSE {
+		sseServer := server.NewSSEServer(mcpServer,
+			server.WithHTTPServer(httpServer),
+			server.WithBaseURL("http://"+addr),
+			server.WithSSEContextFunc(requestIDFromHeader),
+		)
+		mux.Handle(sseServer.CompleteSsePath(), protect(sseServer.SSEHandler()))
+		mux.Handle(sseServer.CompleteMessagePath(), protect(sseServer.MessageHandler()))
+		endpoint, _ := sseServer.CompleteSseEndpoint()
+		return sseServer, endpoint
+	}
+
+	streamOpts := []server.StreamableHTTPOption{
+		server.WithStreamableHTTPServer(httpServer),
+		server.WithHTTPContextFunc(requestIDFromHeader),
+	}
+	scheme := "http"
+	if opts.useTLS() {
+		streamOpts = append(streamOpts, server.WithTLSCert(opts.tlsCert, opts.tlsKey))
+		scheme = "https"
+	}
+	streamServer := server.NewStreamableHTTPServer(mcpServer, streamOpts...)
+	mux.Handle("/mcp", protect(streamServer))
+	return streamServer, scheme + "://" + addr + "/mcp"
+}
+
+// serve starts transport on addr and blocks until it fails or ctx is done. Once ctx is done
+// the transport is shut down, giving in-flight requests up to shutdownTimeout to finish.
+// inFlight counts the running tool calls; it is logged when the drain starts.
+func serve(ctx context.Context, transport httpTransport, addr string, inFlight *atomic.Int64) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- transport.Start(addr)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("MCP server shutting down", slog.Int64("in_flight", inFlight.Load()))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := transport.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// validateKeyPair checks that certFile and keyFile exist and load as a matching keypair.
+func validateKeyPair(certFile, keyFile string) error {
+	if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+		return fmt.Errorf("invalid TLS keypair (cert %q, key %q): %w", certFile, keyFile, err)
+	}
+	return nil
+}
+
 func main() {
-	listenAddr := flag.String("addr", "127.0.0.1", "Listen address")
-	listenPort := flag.Int("port", 6767, "Listen port")
-	flag.Parse()
+	opts, err := parseFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		slog.Error("invalid command-line arguments", slog.Any("error", err))
+		os.Exit(2)
+	}
+	slog.SetDefault(newLogger(os.Stderr, opts.logFormat, opts.logLevel))
+
+	var inFlight atomic.Int64
+	mcpServer := random.NewMCPServer(serverName, serverVersion,
+		random.WithInFlightCounter(&inFlight),
+		random.WithHandlerTimeout(opts.handlerTimeout),
+		random.WithMaxConcurrentHeavy(opts.maxConcurrentHeavy),
+	)
+
+	if opts.transport == transportStdio {
+		if err := server.ServeStdio(mcpServer); err != nil {
+			slog.Error("unable to serve MCP over stdio", slog.Any("error", err))
+			os.Exit(1)
+		}
+		return
+	}
+
+	if opts.useTLS() {
+		if err := validateKeyPair(opts.tlsCert, opts.tlsKey); err != nil {
+			slog.Error("unable to load TLS configuration", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var limiter *httpserver.RateLimiter
+	if opts.rateLimit > 0 {
+		limiter = httpserver.NewRateLimiter(opts.rateLimit)
+		go limiter.Run(ctx, rateLimitCleanupInterval)
+	}
+	protect := func(next http.Handler) http.Handler {
+		handler := httpserver.BearerAuth(opts.authToken, httpserver.MaxBodyBytes(opts.maxBodyBytes, httpserver.Gzip(gzipMinBytes, next)))
+		if limiter != nil {
+			handler = limiter.Middleware(handler)
+		}
+		return handler
+	}
 
-	mcpServer := random.NewMCPServer(serverName, serverVersion)
+	httpServer := &http.Server{}
+	mux := http.NewServeMux()
+	transport, endpoint := newHTTPTransport(opts, mcpServer, httpServer, mux, protect)
+	mux.Handle("/healthz", httpserver.HealthHandler())
+	mux.Handle("/readyz", httpserver.ReadyHandler(func() bool {
+		return len(mcpServer.ListTools()) > 0
+	}))
+	httpServer.Handler = mux
 
-	streamServer := server.NewStreamableHTTPServer(mcpServer)
-	addr := fmt.Sprintf("%s:%d", *listenAddr, *listenPort)
-	slog.Info("MCP server listening", slog.String("url", "http://"+addr+"/mcp"))
-	if err := streamServer.Start(addr); err != nil {
-		slog.Error("unable to start MCP streaming server", slog.Any("error", err))
+	addr := fmt.Sprintf("%s:%d", opts.listenAddr, opts.listenPort)
+	slog.Info("MCP server listening", slog.String("transport", opts.transport), slog.String("url", endpoint))
+	if err := serve(ctx, transport, addr, &inFlight); err != nil {
+		slog.Error("unable to serve MCP over HTTP", slog.Any("error", err))
 		os.Exit(1)
 	}
+	// Handlers abandoned by the handler timeout may still be running.
+	slog.Info("MCP server stopped", slog.Int64("in_flight", inFlight.Load()))
 }
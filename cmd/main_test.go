@@ -0,0 +1,494 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kevensen/go-random-number-mcp/internal/random"
+)
+
+func TestParseFlags(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		args         []string
+		transport    string
+		addr         string
+		port         int
+		rateLimit    float64
+		maxBodyBytes int64
+		timeout      time.Duration
+		maxHeavy     int
+		authToken    string
+		env          string
+		tls          bool
+		logFormat    string
+		logLevel     slog.Level
+		wantErr      bool
+	}{
+		{
+			desc:      "defaults select http",
+			args:      nil,
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+		},
+		{
+			desc:      "explicit http transport",
+			args:      []string{"--transport", "http", "--addr", "0.0.0.0", "--port", "8080"},
+			transport: transportHTTP,
+			addr:      "0.0.0.0",
+			port:      8080,
+		},
+		{
+			desc:      "stdio transport",
+			args:      []string{"--transport=stdio"},
+			transport: transportStdio,
+			addr:      "127.0.0.1",
+			port:      6767,
+		},
+		{
+			desc:      "sse transport",
+			args:      []string{"--transport", "sse", "--port", "9090"},
+			transport: transportSSE,
+			addr:      "127.0.0.1",
+			port:      9090,
+		},
+		{
+			desc:    "sse transport with tls",
+			args:    []string{"--transport", "sse", "--tls-cert", "server.crt", "--tls-key", "server.key"},
+			wantErr: true,
+		},
+		{
+			desc:      "rate limit",
+			args:      []string{"--rate-limit", "2.5"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			rateLimit: 2.5,
+		},
+		{
+			desc:    "negative rate limit",
+			args:    []string{"--rate-limit=-1"},
+			wantErr: true,
+		},
+		{
+			desc:         "max body bytes",
+			args:         []string{"--max-body-bytes", "4096"},
+			transport:    transportHTTP,
+			addr:         "127.0.0.1",
+			port:         6767,
+			maxBodyBytes: 4096,
+		},
+		{
+			desc:    "negative max body bytes",
+			args:    []string{"--max-body-bytes=-1"},
+			wantErr: true,
+		},
+		{
+			desc:      "handler timeout",
+			args:      []string{"--handler-timeout", "5s"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			timeout:   5 * time.Second,
+		},
+		{
+			desc:    "negative handler timeout",
+			args:    []string{"--handler-timeout=-1s"},
+			wantErr: true,
+		},
+		{
+			desc:      "max concurrent heavy",
+			args:      []string{"--max-concurrent-heavy", "4"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			maxHeavy:  4,
+		},
+		{
+			desc:    "negative max concurrent heavy",
+			args:    []string{"--max-concurrent-heavy=-1"},
+			wantErr: true,
+		},
+		{
+			desc:      "auth token flag",
+			args:      []string{"--auth-token", "flag-token"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			authToken: "flag-token",
+		},
+		{
+			desc:      "auth token from environment",
+			env:       "env-token",
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			authToken: "env-token",
+		},
+		{
+			desc:      "auth token flag overrides environment",
+			args:      []string{"--auth-token=flag-token"},
+			env:       "env-token",
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			authToken: "flag-token",
+		},
+		{
+			desc:      "tls cert and key",
+			args:      []string{"--tls-cert", "server.crt", "--tls-key", "server.key"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			tls:       true,
+		},
+		{
+			desc:    "tls cert without key",
+			args:    []string{"--tls-cert", "server.crt"},
+			wantErr: true,
+		},
+		{
+			desc:    "tls key without cert",
+			args:    []string{"--tls-key", "server.key"},
+			wantErr: true,
+		},
+		{
+			desc:      "json logging at debug",
+			args:      []string{"--log-format", "json", "--log-level", "debug"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			logFormat: logFormatJSON,
+			logLevel:  slog.LevelDebug,
+		},
+		{
+			desc:      "log level is case insensitive",
+			args:      []string{"--log-level=WARN"},
+			transport: transportHTTP,
+			addr:      "127.0.0.1",
+			port:      6767,
+			logFormat: logFormatText,
+			logLevel:  slog.LevelWarn,
+		},
+		{
+			desc:    "unknown log format",
+			args:    []string{"--log-format", "xml"},
+			wantErr: true,
+		},
+		{
+			desc:    "unknown log level",
+			args:    []string{"--log-level", "verbose"},
+			wantErr: true,
+		},
+		{
+			desc:    "unknown transport",
+			args:    []string{"--transport", "carrier-pigeon"},
+			wantErr: true,
+		},
+		{
+			desc:    "unknown flag",
+			args:    []string{"--nope"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Setenv(authTokenEnv, tc.env)
+			opts, err := parseFlags(tc.args)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseFlags() expected error, got %+v", opts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseFlags() error = %v", err)
+			}
+			if opts.transport != tc.transport {
+				t.Fatalf("parseFlags() transport = %q, want %q", opts.transport, tc.transport)
+			}
+			if opts.listenAddr != tc.addr || opts.listenPort != tc.port {
+				t.Fatalf("parseFlags() addr = %s:%d, want %s:%d", opts.listenAddr, opts.listenPort, tc.addr, tc.port)
+			}
+			if opts.rateLimit != tc.rateLimit {
+				t.Fatalf("parseFlags() rate limit = %v, want %v", opts.rateLimit, tc.rateLimit)
+			}
+			wantMaxBodyBytes := tc.maxBodyBytes
+			if wantMaxBodyBytes == 0 {
+				wantMaxBodyBytes = defaultMaxBodyBytes
+			}
+			if opts.maxBodyBytes != wantMaxBodyBytes {
+				t.Fatalf("parseFlags() max body bytes = %d, want %d", opts.maxBodyBytes, wantMaxBodyBytes)
+			}
+			wantTimeout := tc.timeout
+			if wantTimeout == 0 {
+				wantTimeout = defaultHandlerTimeout
+			}
+			if opts.handlerTimeout != wantTimeout {
+				t.Fatalf("parseFlags() handler timeout = %s, want %s", opts.handlerTimeout, wantTimeout)
+			}
+			if opts.maxConcurrentHeavy != tc.maxHeavy {
+				t.Fatalf("parseFlags() max concurrent heavy = %d, want %d", opts.maxConcurrentHeavy, tc.maxHeavy)
+			}
+			if opts.authToken != tc.authToken {
+				t.Fatalf("parseFlags() auth token = %q, want %q", opts.authToken, tc.authToken)
+			}
+			wantFormat := tc.logFormat
+			if wantFormat == "" {
+				wantFormat = logFormatText
+			}
+			if opts.logFormat != wantFormat || opts.logLevel != tc.logLevel {
+				t.Fatalf("parseFlags() logging = %s/%s, want %s/%s", opts.logFormat, opts.logLevel, wantFormat, tc.logLevel)
+			}
+			if opts.useTLS() != tc.tls {
+				t.Fatalf("parseFlags() useTLS = %v, want %v", opts.useTLS(), tc.tls)
+			}
+		})
+	}
+}
+
+func TestValidateKeyPair(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedKeyPair(t, dir, "server")
+	_, otherKeyFile := writeSelfSignedKeyPair(t, dir, "other")
+
+	testCases := []struct {
+		desc     string
+		certFile string
+		keyFile  string
+		wantErr  bool
+	}{
+		{
+			desc:     "valid keypair",
+			certFile: certFile,
+			keyFile:  keyFile,
+		},
+		{
+			desc:     "missing certificate",
+			certFile: filepath.Join(dir, "missing.crt"),
+			keyFile:  keyFile,
+			wantErr:  true,
+		},
+		{
+			desc:     "missing key",
+			certFile: certFile,
+			keyFile:  filepath.Join(dir, "missing.key"),
+			wantErr:  true,
+		},
+		{
+			desc:     "mismatched key",
+			certFile: certFile,
+			keyFile:  otherKeyFile,
+			wantErr:  true,
+		},
+		{
+			desc:     "key passed as certificate",
+			certFile: keyFile,
+			keyFile:  keyFile,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := validateKeyPair(tc.certFile, tc.keyFile)
+			if tc.wantErr && err == nil {
+				t.Fatal("validateKeyPair() expected error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("validateKeyPair() error = %v", err)
+			}
+		})
+	}
+}
+
+// writeSelfSignedKeyPair writes a PEM certificate and key named after prefix into dir.
+func writeSelfSignedKeyPair(t *testing.T, dir, prefix string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %v", err)
+	}
+
+	certFile := filepath.Join(dir, prefix+".crt")
+	keyFile := filepath.Join(dir, prefix+".key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("unable to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("unable to write key: %v", err)
+	}
+	return certFile, keyFile
+}
+
+func TestNewLogger(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		format    string
+		level     slog.Level
+		wantDebug bool
+	}{
+		{desc: "text at info", format: logFormatText, level: slog.LevelInfo},
+		{desc: "json at debug", format: logFormatJSON, level: slog.LevelDebug, wantDebug: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := newLogger(&buf, tc.format, tc.level)
+			logger.Debug("debug message")
+			logger.Info("info message", slog.String("key", "value"))
+
+			output := buf.String()
+			if got := strings.Contains(output, "debug message"); got != tc.wantDebug {
+				t.Fatalf("newLogger() debug logged = %v, want %v: %s", got, tc.wantDebug, output)
+			}
+			lines := strings.Split(strings.TrimSpace(output), "\n")
+			last := lines[len(lines)-1]
+			if tc.format == logFormatJSON {
+				var record map[string]any
+				if err := json.Unmarshal([]byte(last), &record); err != nil {
+					t.Fatalf("newLogger() json output %q: %v", last, err)
+				}
+				if record["msg"] != "info message" || record["key"] != "value" {
+					t.Fatalf("newLogger() json record = %v", record)
+				}
+				return
+			}
+			if !strings.Contains(last, `msg="info message"`) || !strings.Contains(last, "key=value") {
+				t.Fatalf("newLogger() text output = %q", last)
+			}
+		})
+	}
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		transport    string
+		wantEndpoint string
+		wantPath     string
+	}{
+		{desc: "streamable http", transport: transportHTTP, wantEndpoint: "http://127.0.0.1:6767/mcp", wantPath: "/mcp"},
+		{desc: "sse", transport: transportSSE, wantEndpoint: "http://127.0.0.1:6767/sse", wantPath: "/sse"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			opts := &options{transport: tc.transport, listenAddr: "127.0.0.1", listenPort: 6767}
+			mcpServer := random.NewMCPServer(serverName, serverVersion)
+			mux := http.NewServeMux()
+			protected := map[string]bool{}
+			protect := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					protected[r.URL.Path] = true
+					next.ServeHTTP(w, r)
+				})
+			}
+
+			transport, endpoint := newHTTPTransport(opts, mcpServer, &http.Server{}, mux, protect)
+			if transport == nil {
+				t.Fatal("newHTTPTransport() returned a nil transport")
+			}
+			if endpoint != tc.wantEndpoint {
+				t.Fatalf("newHTTPTransport() endpoint = %q, want %q", endpoint, tc.wantEndpoint)
+			}
+			if _, pattern := mux.Handler(httptest.NewRequest(http.MethodGet, tc.wantPath, nil)); pattern != tc.wantPath {
+				t.Fatalf("newHTTPTransport() mounted %q, want %q", pattern, tc.wantPath)
+			}
+		})
+	}
+}
+
+func TestNewHTTPTransportServesSSE(t *testing.T) {
+	opts := &options{transport: transportSSE, listenAddr: "127.0.0.1", listenPort: 6767}
+	mux := http.NewServeMux()
+	newHTTPTransport(opts, random.NewMCPServer(serverName, serverVersion), &http.Server{}, mux, func(next http.Handler) http.Handler { return next })
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, testServer.URL+"/sse", nil)
+	if err != nil {
+		t.Fatalf("unable to build request: %v", err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("GET /sse error = %v", err)
+	}
+	defer response.Body.Close()
+
+	if got := response.Header.Get("Content-Type"); got != "text/event-stream" {
+		t.Fatalf("GET /sse content type = %q, want text/event-stream", got)
+	}
+	line, err := bufio.NewReader(response.Body).ReadString('\n')
+	if err != nil {
+		t.Fatalf("unable to read SSE stream: %v", err)
+	}
+	if strings.TrimSpace(line) != "event: endpoint" {
+		t.Fatalf("first SSE line = %q, want the endpoint event", line)
+	}
+}
+
+func TestServeShutsDownWhenContextIsDone(t *testing.T) {
+	for _, transport := range []string{transportHTTP, transportSSE} {
+		t.Run(transport, func(t *testing.T) {
+			opts := &options{transport: transport, listenAddr: "127.0.0.1", listenPort: 0}
+			httpServer := &http.Server{}
+			mux := http.NewServeMux()
+			httpTransport, _ := newHTTPTransport(opts, random.NewMCPServer(serverName, serverVersion), httpServer, mux, func(next http.Handler) http.Handler { return next })
+			httpServer.Handler = mux
+
+			ctx, cancel := context.WithCancel(t.Context())
+			done := make(chan error, 1)
+			go func() {
+				done <- serve(ctx, httpTransport, "127.0.0.1:0", new(atomic.Int64))
+			}()
+			cancel()
+
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Fatalf("serve() error = %v", err)
+				}
+			case <-time.After(shutdownTimeout):
+				t.Fatal("serve() did not return after the context was cancelled")
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ module github.com/kevensen/go-random-number-mcp
 
 go 1.25.5
 
-require github.com/mark3labs/mcp-go v0.43.2
+require (
+	github.com/mark3labs/mcp-go v0.43.2
+	golang.org/x/time v0.14.0
+)
 
 require (
 	github.com/bahlo/generic-list-go v0.2.0 // indirect
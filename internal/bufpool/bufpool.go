@@ -0,0 +1,34 @@
+package bufpool
+
+import "sync"
+
+// maxPooledSize bounds the buffers kept in pool; larger ones are dropped after use rather
+// than holding their memory for the lifetime of the process.
+const maxPooledSize = 64 << 10
+
+// pool holds the scratch buffers that generators read entropy into, so concurrent callers
+// reuse them instead of allocating on every call.
+var pool = sync.Pool{
+	New: func() any { return new([]byte) },
+}
+
+// Get returns a pooled buffer resliced to length n. Hand it back with Put once its bytes
+// have been consumed.
+func Get(n int) *[]byte {
+	buf := pool.Get().(*[]byte)
+	if cap(*buf) < n {
+		*buf = make([]byte, n)
+	}
+	*buf = (*buf)[:n]
+	return buf
+}
+
+// Put clears buf before pooling it, so entropy drawn for one caller is never visible to the
+// next one or left behind in memory.
+func Put(buf *[]byte) {
+	clear((*buf)[:cap(*buf)])
+	if cap(*buf) > maxPooledSize {
+		return
+	}
+	pool.Put(buf)
+}
@@ -0,0 +1,23 @@
+package bufpool
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPutClearsEntropy(t *testing.T) {
+	buf := Get(16)
+	if len(*buf) != 16 {
+		t.Fatalf("Get(16) length = %d, want 16", len(*buf))
+	}
+	copy(*buf, bytes.Repeat([]byte{0xa5}, 16))
+	backing := (*buf)[:cap(*buf)]
+	Put(buf)
+	if !bytes.Equal(backing, make([]byte, len(backing))) {
+		t.Fatalf("Put() left %x in the pooled buffer, want zeros", backing)
+	}
+
+	if grown := Get(maxPooledSize + 1); len(*grown) != maxPooledSize+1 {
+		t.Fatalf("Get(%d) length = %d", maxPooledSize+1, len(*grown))
+	}
+}
@@ -0,0 +1,83 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBearerAuth(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		token         string
+		authorization string
+		wantStatus    int
+	}{
+		{
+			desc:          "authorized",
+			token:         "s3cret",
+			authorization: "Bearer s3cret",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			desc:          "scheme is case insensitive",
+			token:         "s3cret",
+			authorization: "bearer s3cret",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			desc:       "missing header",
+			token:      "s3cret",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:          "wrong token",
+			token:         "s3cret",
+			authorization: "Bearer guess",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			desc:          "token prefix only",
+			token:         "s3cret",
+			authorization: "Bearer s3c",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			desc:          "wrong scheme",
+			token:         "s3cret",
+			authorization: "Basic s3cret",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			desc:       "unconfigured allows anonymous",
+			wantStatus: http.StatusOK,
+		},
+		{
+			desc:          "unconfigured ignores header",
+			authorization: "Bearer anything",
+			wantStatus:    http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handler := BearerAuth(tc.token, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			if tc.authorization != "" {
+				request.Header.Set("Authorization", tc.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if tc.wantStatus == http.StatusUnauthorized && recorder.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Fatalf("WWW-Authenticate = %q, want Bearer", recorder.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMaxBodyBytes(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		limit      int64
+		body       string
+		chunked    bool
+		wantStatus int
+	}{
+		{desc: "under limit", limit: 16, body: `{"id":1}`, wantStatus: http.StatusOK},
+		{desc: "at limit", limit: 8, body: `{"id":1}`, wantStatus: http.StatusOK},
+		{desc: "over limit", limit: 4, body: `{"id":1}`, wantStatus: http.StatusRequestEntityTooLarge},
+		{desc: "over limit without content length", limit: 4, body: `{"id":1}`, chunked: true, wantStatus: http.StatusRequestEntityTooLarge},
+		{desc: "disabled", limit: 0, body: strings.Repeat("x", 1<<16), wantStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var received string
+			handler := MaxBodyBytes(tc.limit, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("next handler read error = %v", err)
+				}
+				received = string(body)
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(tc.body))
+			if tc.chunked {
+				request.ContentLength = -1
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if tc.wantStatus == http.StatusOK {
+				if received != tc.body {
+					t.Fatalf("next handler received %d bytes, want the full %d byte body", len(received), len(tc.body))
+				}
+				return
+			}
+
+			var response errorResponse
+			if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+				t.Fatalf("decode error response: %v", err)
+			}
+			if response.Error != "request body too large" {
+				t.Fatalf("error = %q, want %q", response.Error, "request body too large")
+			}
+		})
+	}
+}
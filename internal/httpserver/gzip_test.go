@@ -0,0 +1,98 @@
+package httpserver
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGzip(t *testing.T) {
+	const minBytes = 64
+	large := strings.Repeat(`{"value":42}`, 100)
+	small := `{"value":42}`
+
+	testCases := []struct {
+		desc           string
+		acceptEncoding string
+		body           string
+		flushFirst     bool
+		wantGzip       bool
+	}{
+		{desc: "large response to gzip client", acceptEncoding: "gzip", body: large, wantGzip: true},
+		{desc: "large response among several encodings", acceptEncoding: "br;q=1.0, gzip;q=0.8", body: large, wantGzip: true},
+		{desc: "small response to gzip client", acceptEncoding: "gzip", body: small},
+		{desc: "large response without accept-encoding", body: large},
+		{desc: "large response with gzip refused", acceptEncoding: "gzip;q=0", body: large},
+		{desc: "flushed response to gzip client", acceptEncoding: "gzip", body: small, flushFirst: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handler := Gzip(minBytes, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusAccepted)
+				if tc.flushFirst {
+					_, _ = io.WriteString(w, tc.body)
+					w.(http.Flusher).Flush()
+					_, _ = io.WriteString(w, tc.body)
+					return
+				}
+				// Write in two halves so the threshold is crossed mid-response.
+				half := len(tc.body) / 2
+				_, _ = io.WriteString(w, tc.body[:half])
+				_, _ = io.WriteString(w, tc.body[half:])
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			if tc.acceptEncoding != "" {
+				request.Header.Set("Accept-Encoding", tc.acceptEncoding)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != http.StatusAccepted {
+				t.Fatalf("status = %d, want %d", recorder.Code, http.StatusAccepted)
+			}
+			if got := recorder.Header().Get("Vary"); got != "Accept-Encoding" {
+				t.Fatalf("Vary = %q, want Accept-Encoding", got)
+			}
+
+			want := tc.body
+			if tc.flushFirst {
+				want = tc.body + tc.body
+			}
+
+			body := recorder.Body.Bytes()
+			if !tc.wantGzip {
+				if got := recorder.Header().Get("Content-Encoding"); got != "" {
+					t.Fatalf("Content-Encoding = %q, want none", got)
+				}
+				if string(body) != want {
+					t.Fatalf("body = %q, want %q", body, want)
+				}
+				return
+			}
+
+			if got := recorder.Header().Get("Content-Encoding"); got != "gzip" {
+				t.Fatalf("Content-Encoding = %q, want gzip", got)
+			}
+			if len(body) >= len(want) {
+				t.Fatalf("compressed body is %d bytes, want fewer than %d", len(body), len(want))
+			}
+			reader, err := gzip.NewReader(recorder.Body)
+			if err != nil {
+				t.Fatalf("gzip.NewReader() error = %v", err)
+			}
+			decompressed, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("read gzip body: %v", err)
+			}
+			if string(decompressed) != want {
+				t.Fatalf("decompressed body = %q, want %q", decompressed, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package httpserver
+
+import "net/http"
+
+const (
+	statusOK       = "ok"
+	statusNotReady = "not ready"
+)
+
+type statusResponse struct {
+	Status string `json:"status"`
+}
+
+// HealthHandler reports liveness. It answers 200 whenever the process can serve requests.
+func HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, statusResponse{Status: statusOK})
+	})
+}
+
+// ReadyHandler reports readiness. It answers 200 once ready returns true and 503 before that.
+func ReadyHandler(ready func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !ready() {
+			writeJSON(w, http.StatusServiceUnavailable, statusResponse{Status: statusNotReady})
+			return
+		}
+		writeJSON(w, http.StatusOK, statusResponse{Status: statusOK})
+	})
+}
@@ -0,0 +1,61 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealthEndpoints(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		handler    http.Handler
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			desc:       "healthz",
+			handler:    HealthHandler(),
+			path:       "/healthz",
+			wantStatus: http.StatusOK,
+			wantBody:   statusOK,
+		},
+		{
+			desc:       "readyz when ready",
+			handler:    ReadyHandler(func() bool { return true }),
+			path:       "/readyz",
+			wantStatus: http.StatusOK,
+			wantBody:   statusOK,
+		},
+		{
+			desc:       "readyz before ready",
+			handler:    ReadyHandler(func() bool { return false }),
+			path:       "/readyz",
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   statusNotReady,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			tc.handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if got := recorder.Header().Get("Content-Type"); got != "application/json" {
+				t.Fatalf("Content-Type = %q, want application/json", got)
+			}
+			var body statusResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("unable to decode body %q: %v", recorder.Body.String(), err)
+			}
+			if body.Status != tc.wantBody {
+				t.Fatalf("status body = %q, want %q", body.Status, tc.wantBody)
+			}
+		})
+	}
+}
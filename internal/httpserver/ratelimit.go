@@ -0,0 +1,98 @@
+package httpserver
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// defaultIdleTimeout is how long a client's bucket is kept after its last request.
+const defaultIdleTimeout = 3 * time.Minute
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter applies a token-bucket limit to each remote IP address.
+type RateLimiter struct {
+	limit       rate.Limit
+	burst       int
+	idleTimeout time.Duration
+
+	mu      sync.Mutex
+	clients map[string]*clientLimiter
+}
+
+// NewRateLimiter returns a RateLimiter that allows requestsPerSecond requests per remote IP,
+// with a burst of the same size rounded up to at least one request.
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	return &RateLimiter{
+		limit:       rate.Limit(requestsPerSecond),
+		burst:       max(1, int(math.Ceil(requestsPerSecond))),
+		idleTimeout: defaultIdleTimeout,
+		clients:     map[string]*clientLimiter{},
+	}
+}
+
+// Middleware rejects requests beyond the client's limit with 429 Too Many Requests.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.allow(clientKey(r), time.Now()) {
+			writeJSONError(w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Run removes idle client buckets every interval until ctx is canceled.
+func (l *RateLimiter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			l.cleanup(now)
+		}
+	}
+}
+
+func (l *RateLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	client, ok := l.clients[key]
+	if !ok {
+		client = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[key] = client
+	}
+	client.lastSeen = now
+	l.mu.Unlock()
+
+	return client.limiter.AllowN(now, 1)
+}
+
+func (l *RateLimiter) cleanup(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, client := range l.clients {
+		if now.Sub(client.lastSeen) > l.idleTimeout {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// clientKey identifies the client by remote IP, dropping the ephemeral port.
+func clientKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,99 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	testCases := []struct {
+		desc              string
+		requestsPerSecond float64
+		requests          []string
+		wantStatus        []int
+	}{
+		{
+			desc:              "single request allowed",
+			requestsPerSecond: 1,
+			requests:          []string{"10.0.0.1:1234"},
+			wantStatus:        []int{http.StatusOK},
+		},
+		{
+			desc:              "burst exhausted for one client",
+			requestsPerSecond: 2,
+			requests:          []string{"10.0.0.1:1234", "10.0.0.1:1235", "10.0.0.1:1236"},
+			wantStatus:        []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			desc:              "clients limited independently",
+			requestsPerSecond: 1,
+			requests:          []string{"10.0.0.1:1234", "10.0.0.2:1234", "10.0.0.1:4321"},
+			wantStatus:        []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			desc:              "fractional rate allows one request",
+			requestsPerSecond: 0.5,
+			requests:          []string{"10.0.0.1:1234", "10.0.0.1:1234"},
+			wantStatus:        []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			limiter := NewRateLimiter(tc.requestsPerSecond)
+			handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			for i, remoteAddr := range tc.requests {
+				request := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+				request.RemoteAddr = remoteAddr
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, request)
+
+				if recorder.Code != tc.wantStatus[i] {
+					t.Fatalf("request %d status = %d, want %d", i, recorder.Code, tc.wantStatus[i])
+				}
+				if recorder.Code == http.StatusTooManyRequests {
+					var body errorResponse
+					if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+						t.Fatalf("request %d invalid JSON error body: %v", i, err)
+					}
+					if body.Error == "" {
+						t.Fatalf("request %d JSON error body missing message", i)
+					}
+					if got := recorder.Header().Get("Content-Type"); got != "application/json" {
+						t.Fatalf("request %d content type = %q, want application/json", i, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterRefillsAndCleansUp(t *testing.T) {
+	limiter := NewRateLimiter(1)
+	now := time.Now()
+
+	if !limiter.allow("10.0.0.1", now) {
+		t.Fatalf("allow() first request denied")
+	}
+	if limiter.allow("10.0.0.1", now) {
+		t.Fatalf("allow() second immediate request allowed")
+	}
+	if !limiter.allow("10.0.0.1", now.Add(time.Second)) {
+		t.Fatalf("allow() request after refill denied")
+	}
+
+	limiter.cleanup(now.Add(time.Second + defaultIdleTimeout/2))
+	if len(limiter.clients) != 1 {
+		t.Fatalf("cleanup() removed an active client")
+	}
+	limiter.cleanup(now.Add(time.Second + 2*defaultIdleTimeout))
+	if len(limiter.clients) != 0 {
+		t.Fatalf("cleanup() kept %d idle clients, want 0", len(limiter.clients))
+	}
+}
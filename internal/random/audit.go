@@ -0,0 +1,103 @@
+package random
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// nonceBytes is the number of random bytes in an audit nonce.
+const nonceBytes = 16
+
+// auditFields are the fields auditMiddleware adds to every successful structured response.
+type auditFields struct {
+	// Nonce is a random hex string unique to this response.
+	Nonce string `json:"nonce"`
+	// GeneratedAt is when the response was produced, in RFC 3339 format with nanoseconds.
+	GeneratedAt string `json:"generatedAt"`
+}
+
+// auditedResponse is a tool's structured response with auditFields attached. It marshals as
+// the response's own JSON object with nonce and generatedAt appended.
+type auditedResponse struct {
+	response any
+	auditFields
+}
+
+func (r auditedResponse) MarshalJSON() ([]byte, error) {
+	body, err := json.Marshal(r.response)
+	if err != nil {
+		return nil, err
+	}
+	audit, err := json.Marshal(r.auditFields)
+	if err != nil {
+		return nil, err
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) < 2 || body[0] != '{' || body[len(body)-1] != '}' {
+		return nil, fmt.Errorf("structured response is not a JSON object: %s", body)
+	}
+	if bytes.Equal(body, []byte("{}")) {
+		return audit, nil
+	}
+	merged := append(body[:len(body)-1:len(body)-1], ',')
+	return append(merged, audit[1:]...), nil
+}
+
+// newNonce returns nonceBytes of crypto/rand output as hex. It deliberately ignores any
+// WithRandReader source, so nonces stay unpredictable and never consume a deterministic
+// stream meant for the tool's own values.
+func newNonce() (string, error) {
+	b := make([]byte, nonceBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", &EntropyError{Err: err}
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// auditMiddleware stamps every successful tool result that has structured content with a
+// nonce and a generatedAt timestamp, and logs both so a response can be tied to its log
+// record. The random values themselves are untouched.
+func auditMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err != nil || result == nil || result.IsError || result.StructuredContent == nil {
+			return result, err
+		}
+
+		nonce, err := newNonce()
+		if err != nil {
+			return toolErrorResult(request.Params.Name, fmt.Errorf("generating audit nonce: %w", err)), nil
+		}
+		fields := auditFields{Nonce: nonce, GeneratedAt: time.Now().UTC().Format(time.RFC3339Nano)}
+		result.StructuredContent = auditedResponse{response: result.StructuredContent, auditFields: fields}
+		slog.InfoContext(ctx, "audit", slog.String("tool", request.Params.Name), slog.String("nonce", fields.Nonce), slog.String("generated_at", fields.GeneratedAt))
+		return result, nil
+	}
+}
+
+// withAuditSchema adds the auditFields to tool's output schema, so clients validating
+// structured content against it accept them.
+func withAuditSchema(tool mcp.Tool) mcp.Tool {
+	if tool.OutputSchema.Type == "" {
+		return tool
+	}
+	properties := make(map[string]any, len(tool.OutputSchema.Properties)+2)
+	for name, property := range tool.OutputSchema.Properties {
+		properties[name] = property
+	}
+	properties["nonce"] = map[string]any{"type": "string"}
+	properties["generatedAt"] = map[string]any{"type": "string", "format": "date-time"}
+	tool.OutputSchema.Properties = properties
+	tool.OutputSchema.Required = append(tool.OutputSchema.Required[:len(tool.OutputSchema.Required):len(tool.OutputSchema.Required)], "nonce", "generatedAt")
+	return tool
+}
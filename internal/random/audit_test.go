@@ -0,0 +1,111 @@
+package random
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestAuditedResponseMarshalJSON(t *testing.T) {
+	fields := auditFields{Nonce: "00ff", GeneratedAt: "2026-01-02T03:04:05Z"}
+	testCases := []struct {
+		desc     string
+		response any
+		want     string
+		wantErr  bool
+	}{
+		{
+			desc:     "fields appended to the response object",
+			response: randomBoolResponse{Value: true, SchemaVersion: 2, Algorithm: algorithmCryptoRand},
+			want:     `{"value":true,"schemaVersion":2,"algorithm":"crypto/rand","nonce":"00ff","generatedAt":"2026-01-02T03:04:05Z"}`,
+		},
+		{
+			desc:     "empty response object",
+			response: struct{}{},
+			want:     `{"nonce":"00ff","generatedAt":"2026-01-02T03:04:05Z"}`,
+		},
+		{
+			desc:     "response that is not an object",
+			response: []int{1, 2},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := json.Marshal(auditedResponse{response: tc.response, auditFields: fields})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("json.Marshal() = %s, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("json.Marshal() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewMCPServerAuditsResponses(t *testing.T) {
+	mcpServer := NewMCPServer("test-server", "0.0.0")
+	call := func(message string) mcp.CallToolResult {
+		t.Helper()
+		response := mcpServer.HandleMessage(t.Context(), json.RawMessage(message))
+		rpcResponse, ok := response.(mcp.JSONRPCResponse)
+		if !ok {
+			t.Fatalf("HandleMessage() response type = %T, want JSONRPCResponse", response)
+		}
+		result, ok := rpcResponse.Result.(mcp.CallToolResult)
+		if !ok {
+			t.Fatalf("HandleMessage() result type = %T, want CallToolResult", rpcResponse.Result)
+		}
+		return result
+	}
+
+	nonces := map[string]bool{}
+	for range 2 {
+		result := call(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int","arguments":{"max":10}}}`)
+		audited, ok := result.StructuredContent.(auditedResponse)
+		if !ok {
+			t.Fatalf("random_int structured content type = %T, want auditedResponse", result.StructuredContent)
+		}
+		if len(audited.Nonce) != 2*nonceBytes {
+			t.Fatalf("random_int nonce = %q, want %d hex digits", audited.Nonce, 2*nonceBytes)
+		}
+		if nonces[audited.Nonce] {
+			t.Fatalf("random_int repeated nonce %q across calls", audited.Nonce)
+		}
+		nonces[audited.Nonce] = true
+		generatedAt, err := time.Parse(time.RFC3339Nano, audited.GeneratedAt)
+		if err != nil {
+			t.Fatalf("random_int generatedAt = %q: %v", audited.GeneratedAt, err)
+		}
+		if age := time.Since(generatedAt); age < 0 || age > time.Minute {
+			t.Fatalf("random_int generatedAt = %s, want about now", generatedAt)
+		}
+		if _, ok := audited.response.(randomIntResponse); !ok {
+			t.Fatalf("random_int audited response type = %T, want randomIntResponse", audited.response)
+		}
+	}
+
+	// Failed calls report an error, not an audited output.
+	result := call(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int","arguments":{"min":10,"max":1}}}`)
+	if _, ok := result.StructuredContent.(randomErrorResponse); !ok || !result.IsError {
+		t.Fatalf("random_int error structured content type = %T, want randomErrorResponse", result.StructuredContent)
+	}
+
+	// Every advertised output schema lists the audit fields.
+	for name, tool := range mcpServer.ListTools() {
+		for _, field := range []string{"nonce", "generatedAt"} {
+			if _, ok := tool.Tool.OutputSchema.Properties[field]; !ok {
+				t.Errorf("tool %q output schema has no %q property", name, field)
+			}
+		}
+	}
+}
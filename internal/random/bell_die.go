@@ -0,0 +1,81 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// randomBellDieResponse reports the summed roll with the individual dice and the theoretical
+// mean the sum clusters around.
+type randomBellDieResponse struct {
+	Total         int64   `json:"total"`
+	Rolls         []int64 `json:"rolls"`
+	Dice          int     `json:"dice"`
+	Sides         int64   `json:"sides"`
+	Mean          float64 `json:"mean"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomBellDieArgs struct {
+	Dice  int   `json:"dice"`
+	Sides int64 `json:"sides"`
+}
+
+func randomBellDieHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomBellDieArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_bell_die", err), nil
+	}
+
+	total, rolls, err := randomBellDie(ctx, args.Dice, args.Sides)
+	if err != nil {
+		return toolErrorResult("random_bell_die", err), nil
+	}
+
+	response := randomBellDieResponse{
+		Total:         total,
+		Rolls:         rolls,
+		Dice:          args.Dice,
+		Sides:         args.Sides,
+		Mean:          bellDieMean(args.Dice, args.Sides),
+		SchemaVersion: schemaVersions["random_bell_die"],
+		Algorithm:     algorithmCryptoRand,
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(total, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomBellDie rolls dice dice of the given number of sides and returns their sum along with
+// the individual rolls. By the central limit theorem the sum approaches a normal distribution
+// centered on bellDieMean as dice grows, which makes mid-range totals the most likely.
+func randomBellDie(ctx context.Context, dice int, sides int64) (int64, []int64, error) {
+	if dice <= 0 || dice > maxDice {
+		return 0, nil, fmt.Errorf("dice must be between 1 and %d", maxDice)
+	}
+	if sides <= 0 || sides > maxDiceSides {
+		return 0, nil, fmt.Errorf("sides must be between 1 and %d", maxDiceSides)
+	}
+
+	rolls, err := rollDice(ctx, dice, sides)
+	if err != nil {
+		return 0, nil, err
+	}
+	var total int64
+	for _, roll := range rolls {
+		total += roll
+	}
+	return total, rolls, nil
+}
+
+// bellDieMean is the expected sum of dice fair dice with the given number of sides: dice*(sides+1)/2.
+func bellDieMean(dice int, sides int64) float64 {
+	return float64(dice) * float64(sides+1) / 2
+}
@@ -0,0 +1,102 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomBellDieHandler(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		dice     int
+		sides    int64
+		wantMean float64
+		wantErr  bool
+	}{
+		{desc: "three six-sided dice", dice: 3, sides: 6, wantMean: 10.5},
+		{desc: "single die", dice: 1, sides: 20, wantMean: 10.5},
+		{desc: "one-sided dice", dice: 4, sides: 1, wantMean: 4},
+		{desc: "maximum dice", dice: maxDice, sides: 6, wantMean: 3500},
+		{desc: "zero dice", dice: 0, sides: 6, wantErr: true},
+		{desc: "negative dice", dice: -2, sides: 6, wantErr: true},
+		{desc: "zero sides", dice: 3, sides: 0, wantErr: true},
+		{desc: "too many dice", dice: maxDice + 1, sides: 6, wantErr: true},
+		{desc: "too many sides", dice: 3, sides: maxDiceSides + 1, wantErr: true},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": tc.dice, "sides": tc.sides}}}
+			result, err := randomBellDieHandler(ctx, request)
+			if err != nil {
+				t.Fatalf("randomBellDieHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomBellDieHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomBellDieHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomBellDieHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomBellDieResponse)
+			if !ok {
+				t.Fatalf("randomBellDieHandler() structured content type = %T, want randomBellDieResponse", result.StructuredContent)
+			}
+			if len(structured.Rolls) != tc.dice {
+				t.Fatalf("randomBellDieHandler() returned %d rolls, want %d", len(structured.Rolls), tc.dice)
+			}
+			var sum int64
+			for _, roll := range structured.Rolls {
+				if roll < 1 || roll > tc.sides {
+					t.Fatalf("randomBellDieHandler() roll %d out of range [1, %d]", roll, tc.sides)
+				}
+				sum += roll
+			}
+			if structured.Total != sum {
+				t.Fatalf("randomBellDieHandler() total = %d, want sum of rolls %d", structured.Total, sum)
+			}
+			if structured.Mean != tc.wantMean || structured.Dice != tc.dice || structured.Sides != tc.sides {
+				t.Fatalf("randomBellDieHandler() = %+v, want %dd%d with mean %g", structured, tc.dice, tc.sides, tc.wantMean)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != strconv.FormatInt(sum, 10) {
+				t.Fatalf("randomBellDieHandler() text content = %+v, want %d", result.Content[0], sum)
+			}
+		})
+	}
+}
+
+func TestRandomBellDieFavorsTheMean(t *testing.T) {
+	// 3d6 rolls 10 or 11 with probability 54/216 = 25%, against 2/216 for the extremes 3 or 18.
+	const samples = 20000
+	middle, extremes := 0, 0
+	for range samples {
+		total, _, err := randomBellDie(t.Context(), 3, 6)
+		if err != nil {
+			t.Fatalf("randomBellDie() error = %v", err)
+		}
+		switch total {
+		case 10, 11:
+			middle++
+		case 3, 18:
+			extremes++
+		}
+	}
+
+	if got := float64(middle) / samples; math.Abs(got-0.25) > 0.02 {
+		t.Fatalf("randomBellDie(3, 6) share of 10s and 11s = %.4f, want about 0.25", got)
+	}
+	if got := float64(extremes) / samples; got > 0.02 {
+		t.Fatalf("randomBellDie(3, 6) share of 3s and 18s = %.4f, want about 0.009", got)
+	}
+}
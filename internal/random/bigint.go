@@ -0,0 +1,118 @@
+package random
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxBigIntBits bounds the size of random_bigint ranges, both for bits and for the magnitude
+// of minString and maxString.
+const maxBigIntBits = 4096
+
+// randomBigIntResponse holds the value as a decimal string, since it can exceed any JSON
+// number. Bits is the bit length of the range it was drawn from: the requested bits, or the
+// bit length of maxString-minString.
+type randomBigIntResponse struct {
+	Value         string `json:"value"`
+	Bits          int    `json:"bits"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+// randomBigIntArgs selects the range either as [0, 2^bits) or as the inclusive decimal range
+// [minString, maxString].
+type randomBigIntArgs struct {
+	Bits      *int    `json:"bits,omitempty"`
+	MinString *string `json:"minString,omitempty"`
+	MaxString *string `json:"maxString,omitempty"`
+}
+
+func randomBigIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomBigIntArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_bigint", err), nil
+	}
+
+	min, max, err := bigIntRange(args)
+	if err != nil {
+		return toolErrorResult("random_bigint", err), nil
+	}
+
+	value, err := randomBigInt(ctx, min, max)
+	if err != nil {
+		return toolErrorResult("random_bigint", err), nil
+	}
+
+	bits := new(big.Int).Sub(max, min).BitLen()
+	if args.Bits != nil {
+		bits = *args.Bits
+	}
+	response := randomBigIntResponse{Value: value.String(), Bits: bits, SchemaVersion: schemaVersions["random_bigint"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: response.Value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// bigIntRange validates args and returns the inclusive range they describe. Exactly one of
+// bits or the minString/maxString pair must be given.
+func bigIntRange(args randomBigIntArgs) (*big.Int, *big.Int, error) {
+	hasBounds := args.MinString != nil || args.MaxString != nil
+	switch {
+	case args.Bits != nil && hasBounds:
+		return nil, nil, errors.New("bits cannot be combined with minString and maxString")
+	case args.Bits != nil:
+		bits := *args.Bits
+		if bits <= 0 || bits > maxBigIntBits {
+			return nil, nil, fmt.Errorf("bits must be between 1 and %d", maxBigIntBits)
+		}
+		max := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+		return big.NewInt(0), max.Sub(max, big.NewInt(1)), nil
+	case args.MinString == nil || args.MaxString == nil:
+		return nil, nil, errors.New("either bits or both minString and maxString are required")
+	}
+
+	min, err := parseBigInt("minString", *args.MinString)
+	if err != nil {
+		return nil, nil, err
+	}
+	max, err := parseBigInt("maxString", *args.MaxString)
+	if err != nil {
+		return nil, nil, err
+	}
+	if min.Cmp(max) > 0 {
+		return nil, nil, &RangeError{}
+	}
+	return min, max, nil
+}
+
+// parseBigInt parses a decimal integer of at most maxBigIntBits bits, naming the argument in errors.
+func parseBigInt(name, value string) (*big.Int, error) {
+	parsed, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%s %q is not a decimal integer", name, value)
+	}
+	if parsed.BitLen() > maxBigIntBits {
+		return nil, fmt.Errorf("%s cannot exceed %d bits", name, maxBigIntBits)
+	}
+	return parsed, nil
+}
+
+// randomBigInt returns a uniformly random integer in the inclusive range [min, max] using
+// crypto/rand.Int, which rejection samples so every value is equally likely.
+func randomBigInt(ctx context.Context, min, max *big.Int) (*big.Int, error) {
+	size := new(big.Int).Sub(max, min)
+	size.Add(size, big.NewInt(1))
+	offset, err := rand.Int(sourceFromContext(ctx), size)
+	if err != nil {
+		return nil, err
+	}
+	return offset.Add(offset, min), nil
+}
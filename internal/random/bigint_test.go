@@ -0,0 +1,121 @@
+package random
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomBigIntHandler(t *testing.T) {
+	huge := "1" + strings.Repeat("0", 40)
+	testCases := []struct {
+		desc     string
+		args     map[string]any
+		min      string
+		max      string
+		wantBits int
+		wantErr  bool
+	}{
+		{
+			desc:     "bits",
+			args:     map[string]any{"bits": 256},
+			min:      "0",
+			max:      new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)).String(),
+			wantBits: 256,
+		},
+		{
+			desc:     "single bit",
+			args:     map[string]any{"bits": 1},
+			min:      "0",
+			max:      "1",
+			wantBits: 1,
+		},
+		{
+			desc:     "range beyond int64",
+			args:     map[string]any{"minString": "-" + huge, "maxString": huge},
+			min:      "-" + huge,
+			max:      huge,
+			wantBits: 134,
+		},
+		{
+			desc:     "single value range",
+			args:     map[string]any{"minString": huge, "maxString": huge},
+			min:      huge,
+			max:      huge,
+			wantBits: 0,
+		},
+		{desc: "no args", args: nil, wantErr: true},
+		{desc: "zero bits", args: map[string]any{"bits": 0}, wantErr: true},
+		{desc: "too many bits", args: map[string]any{"bits": maxBigIntBits + 1}, wantErr: true},
+		{desc: "bits with bounds", args: map[string]any{"bits": 8, "minString": "0", "maxString": "1"}, wantErr: true},
+		{desc: "min without max", args: map[string]any{"minString": "0"}, wantErr: true},
+		{desc: "malformed min", args: map[string]any{"minString": "1e10", "maxString": "2"}, wantErr: true},
+		{desc: "min greater than max", args: map[string]any{"minString": huge, "maxString": "-" + huge}, wantErr: true},
+		{desc: "bound too large", args: map[string]any{"minString": "0", "maxString": "1" + strings.Repeat("0", 1300)}, wantErr: true},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			result, err := randomBigIntHandler(ctx, request)
+			if err != nil {
+				t.Fatalf("randomBigIntHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomBigIntHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomBigIntHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomBigIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomBigIntResponse)
+			if !ok {
+				t.Fatalf("randomBigIntHandler() structured content type = %T, want randomBigIntResponse", result.StructuredContent)
+			}
+			value, ok := new(big.Int).SetString(structured.Value, 10)
+			if !ok {
+				t.Fatalf("randomBigIntHandler() value %q is not a decimal integer", structured.Value)
+			}
+			min, _ := new(big.Int).SetString(tc.min, 10)
+			max, _ := new(big.Int).SetString(tc.max, 10)
+			if value.Cmp(min) < 0 || value.Cmp(max) > 0 {
+				t.Fatalf("randomBigIntHandler() value %s out of range [%s, %s]", value, min, max)
+			}
+			if structured.Bits != tc.wantBits {
+				t.Fatalf("randomBigIntHandler() bits = %d, want %d", structured.Bits, tc.wantBits)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Value {
+				t.Fatalf("randomBigIntHandler() text content = %+v, want %q", result.Content[0], structured.Value)
+			}
+		})
+	}
+}
+
+func TestRandomBigIntKnownValue(t *testing.T) {
+	// crypto/rand.Int reads ceil(bits/8) bytes for a range of 2^bits values and masks nothing
+	// when bits is a multiple of 8, so the offset is the big-endian entropy itself.
+	entropy := bytes.Repeat([]byte{0xff}, 16)
+	ctx := withSource(t.Context(), securerand.New(bytes.NewReader(entropy)))
+	min, _ := new(big.Int).SetString("-170141183460469231731687303715884105728", 10)
+	max, _ := new(big.Int).SetString("170141183460469231731687303715884105727", 10)
+
+	value, err := randomBigInt(ctx, min, max)
+	if err != nil {
+		t.Fatalf("randomBigInt() error = %v", err)
+	}
+	if value.Cmp(max) != 0 {
+		t.Fatalf("randomBigInt() = %s, want %s", value, max)
+	}
+}
@@ -0,0 +1,107 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// binomialExactThreshold is the largest n for which random_binomial sums individual
+	// Bernoulli trials; larger n use an approximation.
+	binomialExactThreshold = 1000
+	// binomialNormalMinVariance is the smallest n*p*(1-p) for which the normal approximation
+	// is used for large n; below it the rarer outcome is approximated as Poisson instead.
+	binomialNormalMinVariance = 10
+	// maxBinomialTrials keeps approximated samples well inside the int64 range.
+	maxBinomialTrials = 1e12
+)
+
+type randomBinomialResponse struct {
+	Value         int64   `json:"value"`
+	N             int     `json:"n"`
+	P             float64 `json:"p"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomBinomialArgs struct {
+	N int     `json:"n"`
+	P float64 `json:"p"`
+}
+
+func randomBinomialHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomBinomialArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_binomial", err), nil
+	}
+
+	value, err := randomBinomial(ctx, args.N, args.P)
+	if err != nil {
+		return toolErrorResult("random_binomial", err), nil
+	}
+
+	response := randomBinomialResponse{Value: value, N: args.N, P: args.P, SchemaVersion: schemaVersions["random_binomial"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(value, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomBinomial returns the number of successes in n independent trials that each succeed
+// with probability p. N must be between 1 and maxBinomialTrials and p must lie in [0, 1].
+// Up to binomialExactThreshold trials are simulated directly. Beyond that a normal
+// approximation is used, or a Poisson approximation of the rarer outcome when the variance
+// is too small for the normal approximation to be accurate.
+func randomBinomial(ctx context.Context, n int, p float64) (int64, error) {
+	if n <= 0 || n > maxBinomialTrials {
+		return 0, fmt.Errorf("n must be between 1 and %g", float64(maxBinomialTrials))
+	}
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return 0, fmt.Errorf("p must be between 0 and 1")
+	}
+	if p == 0 || p == 1 {
+		return int64(float64(n) * p), nil
+	}
+
+	if n <= binomialExactThreshold {
+		var successes int64
+		for i := range n {
+			if err := checkCancelled(ctx, i); err != nil {
+				return 0, err
+			}
+			unit, err := sourceFromContext(ctx).UnitFloat64()
+			if err != nil {
+				return 0, err
+			}
+			if unit < p {
+				successes++
+			}
+		}
+		return successes, nil
+	}
+
+	mean := float64(n) * p
+	variance := mean * (1 - p)
+	if variance < binomialNormalMinVariance {
+		// Count whichever outcome is rare; its total is close to Poisson with the same mean.
+		if p <= 0.5 {
+			rare, err := randomPoisson(ctx, mean)
+			return min(rare, int64(n)), err
+		}
+		rare, err := randomPoisson(ctx, float64(n)*(1-p))
+		return int64(n) - min(rare, int64(n)), err
+	}
+
+	z, _, err := standardNormalPair(ctx)
+	if err != nil {
+		return 0, err
+	}
+	value := math.Round(mean + math.Sqrt(variance)*z)
+	return int64(math.Min(math.Max(value, 0), float64(n))), nil
+}
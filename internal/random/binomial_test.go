@@ -0,0 +1,141 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomBinomialHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		n       int
+		p       float64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with small n",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 10, "p": 0.5}}},
+			n:       10,
+			p:       0.5,
+		},
+		{
+			desc:    "valid request with large n",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 1000000, "p": 0.3}}},
+			n:       1000000,
+			p:       0.3,
+		},
+		{
+			desc:    "valid request with zero probability",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 5, "p": 0.0}}},
+			n:       5,
+			p:       0,
+		},
+		{
+			desc:    "valid request with certain success",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 5, "p": 1.0}}},
+			n:       5,
+			p:       1,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with probability above one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 5, "p": 1.5}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative probability",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 5, "p": -0.1}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomBinomialHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomBinomialHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomBinomialHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomBinomialHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomBinomialHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomBinomialHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			valueFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+			if err != nil {
+				t.Fatalf("randomBinomialHandler() invalid text content: %v", err)
+			}
+
+			structured, ok := result.StructuredContent.(randomBinomialResponse)
+			if !ok {
+				t.Fatalf("randomBinomialHandler() structured content type = %T, want randomBinomialResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomBinomialHandler() structured value = %d, text value = %d", structured.Value, valueFromText)
+			}
+			if structured.Value < 0 || structured.Value > int64(tc.n) {
+				t.Fatalf("randomBinomialHandler() value = %d, want within [0, %d]", structured.Value, tc.n)
+			}
+			if structured.N != tc.n || structured.P != tc.p {
+				t.Fatalf("randomBinomialHandler() n/p = %d/%g, want %d/%g", structured.N, structured.P, tc.n, tc.p)
+			}
+			if tc.p == 0 && structured.Value != 0 || tc.p == 1 && structured.Value != int64(tc.n) {
+				t.Fatalf("randomBinomialHandler() value = %d for p = %g", structured.Value, tc.p)
+			}
+		})
+	}
+}
+
+func TestRandomBinomialMean(t *testing.T) {
+	testCases := []struct {
+		desc string
+		n    int
+		p    float64
+	}{
+		{desc: "exact trials", n: 20, p: 0.3},
+		{desc: "normal approximation", n: 100000, p: 0.4},
+		{desc: "rare successes", n: 1000000, p: 0.000002},
+		{desc: "rare failures", n: 1000000, p: 0.999998},
+	}
+
+	const samples = 4000
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var sum float64
+			for i := 0; i < samples; i++ {
+				value, err := randomBinomial(t.Context(), tc.n, tc.p)
+				if err != nil {
+					t.Fatalf("randomBinomial(%d, %g) error = %v", tc.n, tc.p, err)
+				}
+				sum += float64(value)
+			}
+
+			// The sample mean has standard error sqrt(n*p*(1-p)/samples); allow six of them.
+			want := float64(tc.n) * tc.p
+			tolerance := 6 * math.Sqrt(want*(1-tc.p)/samples)
+			if mean := sum / samples; math.Abs(mean-want) > tolerance {
+				t.Fatalf("randomBinomial(%d, %g) sample mean = %f, want within %f of %f", tc.n, tc.p, mean, tolerance, want)
+			}
+		})
+	}
+}
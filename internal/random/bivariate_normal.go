@@ -0,0 +1,91 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomBivariateNormalResponse struct {
+	X             float64 `json:"x"`
+	Y             float64 `json:"y"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomBivariateNormalArgs struct {
+	MeanX       *float64 `json:"meanX,omitempty"`
+	MeanY       *float64 `json:"meanY,omitempty"`
+	StdDevX     *float64 `json:"stddevX,omitempty"`
+	StdDevY     *float64 `json:"stddevY,omitempty"`
+	Correlation float64  `json:"correlation,omitempty"`
+}
+
+// bivariateNormal describes a two-dimensional normal distribution by its marginal means and
+// standard deviations and the correlation between the two coordinates.
+type bivariateNormal struct {
+	meanX, meanY     float64
+	stddevX, stddevY float64
+	correlation      float64
+}
+
+func randomBivariateNormalHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomBivariateNormalArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_bivariate_normal", err), nil
+	}
+
+	dist := bivariateNormal{stddevX: 1, stddevY: 1, correlation: args.Correlation}
+	if args.MeanX != nil {
+		dist.meanX = *args.MeanX
+	}
+	if args.MeanY != nil {
+		dist.meanY = *args.MeanY
+	}
+	if args.StdDevX != nil {
+		dist.stddevX = *args.StdDevX
+	}
+	if args.StdDevY != nil {
+		dist.stddevY = *args.StdDevY
+	}
+
+	x, y, err := randomBivariateNormal(ctx, dist)
+	if err != nil {
+		return toolErrorResult("random_bivariate_normal", err), nil
+	}
+
+	response := randomBivariateNormalResponse{X: x, Y: y, SchemaVersion: schemaVersions["random_bivariate_normal"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%g,%g", x, y)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomBivariateNormal returns a correlated pair drawn from dist. Two independent standard
+// normals z0, z1 from one Box-Muller transform are mapped through the Cholesky factor of the
+// correlation matrix, giving x = z0 and y = rho*z0 + sqrt(1-rho^2)*z1, and then scaled and
+// shifted to the requested marginals.
+func randomBivariateNormal(ctx context.Context, dist bivariateNormal) (float64, float64, error) {
+	if err := validateGaussianParams(dist.meanX, dist.stddevX); err != nil {
+		return 0, 0, fmt.Errorf("x: %w", err)
+	}
+	if err := validateGaussianParams(dist.meanY, dist.stddevY); err != nil {
+		return 0, 0, fmt.Errorf("y: %w", err)
+	}
+	if math.IsNaN(dist.correlation) || dist.correlation < -1 || dist.correlation > 1 {
+		return 0, 0, fmt.Errorf("correlation must be between -1 and 1")
+	}
+
+	z0, z1, err := standardNormalPair(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	rho := dist.correlation
+	x := dist.meanX + dist.stddevX*z0
+	y := dist.meanY + dist.stddevY*(rho*z0+math.Sqrt(1-rho*rho)*z1)
+	return x, y, nil
+}
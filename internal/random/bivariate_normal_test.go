@@ -0,0 +1,120 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomBivariateNormalHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+		},
+		{
+			desc:    "valid request with all parameters",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"meanX": 10.0, "meanY": -5.0, "stddevX": 2.0, "stddevY": 0.5, "correlation": 0.8}}},
+		},
+		{
+			desc:    "valid request with perfect negative correlation",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"correlation": -1.0}}},
+		},
+		{
+			desc:    "invalid request with correlation above one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"correlation": 1.5}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero stddevX",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"stddevX": 0.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative stddevY",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"stddevY": -1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomBivariateNormalHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomBivariateNormalHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomBivariateNormalHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomBivariateNormalHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomBivariateNormalHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomBivariateNormalResponse)
+			if !ok {
+				t.Fatalf("randomBivariateNormalHandler() structured content type = %T, want randomBivariateNormalResponse", result.StructuredContent)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != fmt.Sprintf("%g,%g", structured.X, structured.Y) {
+				t.Fatalf("randomBivariateNormalHandler() text content = %+v, want x,y", result.Content[0])
+			}
+		})
+	}
+}
+
+func TestRandomBivariateNormalMoments(t *testing.T) {
+	const samples = 20000
+	testCases := []struct {
+		desc string
+		dist bivariateNormal
+	}{
+		{desc: "positive correlation", dist: bivariateNormal{meanX: 1, meanY: -2, stddevX: 2, stddevY: 0.5, correlation: 0.7}},
+		{desc: "negative correlation", dist: bivariateNormal{stddevX: 1, stddevY: 3, correlation: -0.4}},
+		{desc: "uncorrelated", dist: bivariateNormal{stddevX: 1, stddevY: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var sumX, sumY, sumXX, sumYY, sumXY float64
+			for range samples {
+				x, y, err := randomBivariateNormal(t.Context(), tc.dist)
+				if err != nil {
+					t.Fatalf("randomBivariateNormal() error = %v", err)
+				}
+				sumX += x
+				sumY += y
+				sumXX += x * x
+				sumYY += y * y
+				sumXY += x * y
+			}
+
+			meanX, meanY := sumX/samples, sumY/samples
+			stddevX := math.Sqrt(sumXX/samples - meanX*meanX)
+			stddevY := math.Sqrt(sumYY/samples - meanY*meanY)
+			correlation := (sumXY/samples - meanX*meanY) / (stddevX * stddevY)
+
+			if math.Abs(correlation-tc.dist.correlation) > 0.03 {
+				t.Fatalf("randomBivariateNormal() correlation = %.4f, want about %g", correlation, tc.dist.correlation)
+			}
+			if math.Abs(meanX-tc.dist.meanX) > 0.1*tc.dist.stddevX || math.Abs(meanY-tc.dist.meanY) > 0.1*tc.dist.stddevY {
+				t.Fatalf("randomBivariateNormal() means = (%.4f, %.4f), want about (%g, %g)", meanX, meanY, tc.dist.meanX, tc.dist.meanY)
+			}
+			if math.Abs(stddevX/tc.dist.stddevX-1) > 0.05 || math.Abs(stddevY/tc.dist.stddevY-1) > 0.05 {
+				t.Fatalf("randomBivariateNormal() stddevs = (%.4f, %.4f), want about (%g, %g)", stddevX, stddevY, tc.dist.stddevX, tc.dist.stddevY)
+			}
+		})
+	}
+}
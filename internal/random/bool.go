@@ -0,0 +1,62 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomBoolResponse struct {
+	Value         bool   `json:"value"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomBoolArgs struct {
+	Probability *float64 `json:"probability,omitempty"`
+}
+
+func randomBoolHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomBoolArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_bool", err), nil
+	}
+
+	probability := 0.5
+	if args.Probability != nil {
+		probability = *args.Probability
+	}
+
+	value, err := randomBool(ctx, probability)
+	if err != nil {
+		return toolErrorResult("random_bool", err), nil
+	}
+
+	response := randomBoolResponse{Value: value, SchemaVersion: schemaVersions["random_bool"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatBool(value)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomBool returns true with the given probability, which must lie in [0, 1].
+func randomBool(ctx context.Context, probability float64) (bool, error) {
+	if math.IsNaN(probability) || math.IsInf(probability, 0) {
+		return false, fmt.Errorf("probability must be finite")
+	}
+	if probability < 0 || probability > 1 {
+		return false, fmt.Errorf("probability must be between 0 and 1")
+	}
+
+	unit, err := sourceFromContext(ctx).UnitFloat64()
+	if err != nil {
+		return false, err
+	}
+
+	return unit < probability, nil
+}
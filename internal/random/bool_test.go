@@ -0,0 +1,101 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomBoolHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		fixed   bool
+		want    bool
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+		},
+		{
+			desc:    "valid request with probability 0",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.0}}},
+			fixed:   true,
+			want:    false,
+		},
+		{
+			desc:    "valid request with probability 1",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 1.0}}},
+			fixed:   true,
+			want:    true,
+		},
+		{
+			desc:    "valid request with probability 0.25",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.25}}},
+		},
+		{
+			desc:    "invalid request with negative probability",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": -0.1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with probability above 1",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 1.5}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomBoolHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomBoolHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomBoolHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomBoolHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomBoolHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomBoolHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			valueFromText, err := strconv.ParseBool(textContent.Text)
+			if err != nil {
+				t.Fatalf("randomBoolHandler() invalid text content: %v", err)
+			}
+			if tc.fixed && valueFromText != tc.want {
+				t.Fatalf("randomBoolHandler() value = %t, want %t", valueFromText, tc.want)
+			}
+
+			structured, ok := result.StructuredContent.(randomBoolResponse)
+			if !ok {
+				t.Fatalf("randomBoolHandler() structured content type = %T, want randomBoolResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomBoolHandler() structured value %t != text value %t", structured.Value, valueFromText)
+			}
+		})
+	}
+}
+
+func TestRandomBoolRejectsNonFinite(t *testing.T) {
+	for _, p := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := randomBool(t.Context(), p); err == nil {
+			t.Fatalf("randomBool(%v) expected error, got nil", p)
+		}
+	}
+}
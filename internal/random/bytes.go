@@ -0,0 +1,79 @@
+package random
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+
+	"github.com/kevensen/go-random-number-mcp/internal/bufpool"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxBytesLength caps the number of bytes random_bytes will generate in a single call.
+const maxBytesLength = 1 << 20
+
+type randomBytesResponse struct {
+	Value         string  `json:"value"`
+	Encoding      string  `json:"encoding"`
+	EntropyBits   float64 `json:"entropyBits"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomBytesArgs struct {
+	Length   int    `json:"length"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+func randomBytesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomBytesArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_bytes", err), nil
+	}
+
+	encoding := "hex"
+	if args.Encoding != "" {
+		encoding = args.Encoding
+	}
+
+	value, err := randomEncodedBytes(ctx, args.Length, encoding)
+	if err != nil {
+		return toolErrorResult("random_bytes", err), nil
+	}
+	slog.InfoContext(ctx, "randomBytesHandler", slog.Int("length", args.Length), slog.String("encoding", encoding), resultAttr("random_bytes", value))
+
+	response := randomBytesResponse{Value: value, Encoding: encoding, EntropyBits: entropyBits(args.Length, 256), SchemaVersion: schemaVersions["random_bytes"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomEncodedBytes returns length cryptographically secure random bytes encoded as hex or base64.
+// Length must be greater than zero and no more than maxBytesLength.
+func randomEncodedBytes(ctx context.Context, length int, encoding string) (string, error) {
+	if length <= 0 {
+		return "", &ZeroLengthError{}
+	}
+	if length > maxBytesLength {
+		return "", fmt.Errorf("length cannot exceed %d bytes", maxBytesLength)
+	}
+	if encoding != "hex" && encoding != "base64" {
+		return "", fmt.Errorf("unsupported encoding %q: must be hex or base64", encoding)
+	}
+
+	buf := bufpool.Get(length)
+	defer bufpool.Put(buf)
+	if _, err := sourceFromContext(ctx).Read(*buf); err != nil {
+		return "", err
+	}
+
+	if encoding == "base64" {
+		return base64.StdEncoding.EncodeToString(*buf), nil
+	}
+	return hex.EncodeToString(*buf), nil
+}
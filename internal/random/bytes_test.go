@@ -0,0 +1,109 @@
+package random
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomBytesHandler(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		request  mcp.CallToolRequest
+		length   int
+		encoding string
+		wantErr  bool
+	}{
+		{
+			desc:    "invalid request with zero length",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative length",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": -1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with length over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": maxBytesLength + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with unknown encoding",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "encoding": "base32"}}},
+			wantErr: true,
+		},
+		{
+			desc:     "valid request with default encoding",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 16}}},
+			length:   16,
+			encoding: "hex",
+		},
+		{
+			desc:     "valid request with hex encoding",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 1, "encoding": "hex"}}},
+			length:   1,
+			encoding: "hex",
+		},
+		{
+			desc:     "valid request with base64 encoding",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32, "encoding": "base64"}}},
+			length:   32,
+			encoding: "base64",
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomBytesHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomBytesHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomBytesHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomBytesHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomBytesHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomBytesHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			var decoded []byte
+			if tc.encoding == "base64" {
+				decoded, err = base64.StdEncoding.DecodeString(textContent.Text)
+			} else {
+				decoded, err = hex.DecodeString(textContent.Text)
+			}
+			if err != nil {
+				t.Fatalf("randomBytesHandler() invalid %s text content: %v", tc.encoding, err)
+			}
+			if len(decoded) != tc.length {
+				t.Fatalf("randomBytesHandler() decoded length = %d, want %d", len(decoded), tc.length)
+			}
+
+			structured, ok := result.StructuredContent.(randomBytesResponse)
+			if !ok {
+				t.Fatalf("randomBytesHandler() structured content type = %T, want randomBytesResponse", result.StructuredContent)
+			}
+			if structured.Value != textContent.Text {
+				t.Fatalf("randomBytesHandler() structured value != text value")
+			}
+			if structured.Encoding != tc.encoding {
+				t.Fatalf("randomBytesHandler() structured encoding = %q, want %q", structured.Encoding, tc.encoding)
+			}
+		})
+	}
+}
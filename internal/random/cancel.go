@@ -0,0 +1,17 @@
+package random
+
+import "context"
+
+// cancelCheckInterval is how many iterations a generation loop runs between checks of its
+// context. Checking on every draw would cost more than the draw for the cheap generators,
+// while this still stops a cancelled or timed-out request within a few milliseconds.
+const cancelCheckInterval = 1024
+
+// checkCancelled returns ctx.Err() on every cancelCheckInterval-th iteration of a loop,
+// starting with iteration 0, and nil on the others.
+func checkCancelled(ctx context.Context, i int) error {
+	if i%cancelCheckInterval != 0 {
+		return nil
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,136 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// countingReader is an endless entropy source that records how many bytes were drawn.
+type countingReader struct {
+	n int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r.n + i)
+	}
+	r.n += len(p)
+	return len(p), nil
+}
+
+func TestGeneratorsStopWhenContextIsCancelled(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		generate func(context.Context) error
+	}{
+		{desc: "ascii", generate: func(ctx context.Context) error {
+			_, err := randomASCIIInRange(ctx, defaultMaxASCIILength, asciiPrintableMin, asciiPrintableMax)
+			return err
+		}},
+		{desc: "ascii with custom range", generate: func(ctx context.Context) error {
+			_, err := randomASCIIInRange(ctx, defaultMaxASCIILength, 'A', 'Z')
+			return err
+		}},
+		{desc: "string with charset", generate: func(ctx context.Context) error {
+			_, err := randomStringWithCharset(ctx, 10000, "abc")
+			return err
+		}},
+		{desc: "ints", generate: func(ctx context.Context) error {
+			_, err := randomInts(ctx, maxIntCount, 0, 100, 1, nil)
+			return err
+		}},
+		{desc: "gaussians", generate: func(ctx context.Context) error {
+			_, err := randomGaussians(ctx, 0, 1, maxGaussianCount)
+			return err
+		}},
+		{desc: "indices", generate: func(ctx context.Context) error {
+			_, err := randomIndices(ctx, 100, maxIndexCount)
+			return err
+		}},
+		{desc: "words", generate: func(ctx context.Context) error {
+			_, err := randomWords(ctx, 10000)
+			return err
+		}},
+		{desc: "shuffle", generate: func(ctx context.Context) error {
+			_, err := shuffledCopy(ctx, make([]int, 10000))
+			return err
+		}},
+		{desc: "sample with replacement", generate: func(ctx context.Context) error {
+			_, err := randomSampleIndicesWithReplacement(ctx, 10, maxSampleReplacementK)
+			return err
+		}},
+		{desc: "dice", generate: func(ctx context.Context) error {
+			_, err := rollDice(ctx, 10000, 6)
+			return err
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			source := &countingReader{}
+			ctx, cancel := context.WithCancel(withSource(t.Context(), securerand.New(source)))
+			cancel()
+
+			if err := tc.generate(ctx); !errors.Is(err, context.Canceled) {
+				t.Fatalf("%s with cancelled context error = %v, want %v", tc.desc, err, context.Canceled)
+			}
+			if source.n != 0 {
+				t.Fatalf("%s with cancelled context drew %d bytes, want 0", tc.desc, source.n)
+			}
+		})
+	}
+}
+
+func TestGeneratorsStopPartwayWhenContextIsCancelled(t *testing.T) {
+	// Cancel from inside the source once the first chunk has been drawn; the generator must
+	// notice at its next check rather than finishing the whole string.
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	source := &cancellingReader{cancel: cancel, after: 64}
+	ctx = withSource(ctx, securerand.New(source))
+
+	if _, err := randomASCIIInRange(ctx, defaultMaxASCIILength, asciiPrintableMin, asciiPrintableMax); !errors.Is(err, context.Canceled) {
+		t.Fatalf("randomASCIIInRange() error = %v, want %v", err, context.Canceled)
+	}
+	if source.n >= defaultMaxASCIILength {
+		t.Fatalf("randomASCIIInRange() drew %d bytes after cancellation, want it to stop early", source.n)
+	}
+}
+
+// cancellingReader is an endless entropy source that calls cancel once after bytes have been drawn.
+type cancellingReader struct {
+	countingReader
+	cancel func()
+	after  int
+}
+
+func (r *cancellingReader) Read(p []byte) (int, error) {
+	n, err := r.countingReader.Read(p)
+	if r.n >= r.after {
+		r.cancel()
+	}
+	return n, err
+}
+
+func TestRandomASCIIHandlerReportsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 16}}}
+	result, err := randomASCIIHandler(ctx, request)
+	if err != nil {
+		t.Fatalf("randomASCIIHandler() error = %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("randomASCIIHandler() with cancelled context succeeded: %+v", result.Content)
+	}
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok || !strings.Contains(textContent.Text, context.Canceled.Error()) {
+		t.Fatalf("randomASCIIHandler() error content = %+v, want %q", result.Content[0], context.Canceled)
+	}
+}
@@ -0,0 +1,85 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// cardRanks and cardSuits build the standard 52-card deck. Cards are written rank then suit,
+// e.g. "AS" for the ace of spades or "10H" for the ten of hearts.
+var (
+	cardRanks = []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K"}
+	cardSuits = []string{"S", "H", "D", "C"}
+)
+
+// cardJokers are added to the deck when jokers are requested: a black and a red joker, so
+// the two are distinguishable like every other card.
+var cardJokers = []string{"BJ", "RJ"}
+
+type randomCardResponse struct {
+	Cards         []string `json:"cards"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Algorithm     string   `json:"algorithm"`
+}
+
+type randomCardArgs struct {
+	Count  *int `json:"count,omitempty"`
+	Jokers bool `json:"jokers,omitempty"`
+}
+
+func randomCardHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomCardArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_card", err), nil
+	}
+
+	count := 1
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	cards, err := randomCards(ctx, count, args.Jokers)
+	if err != nil {
+		return toolErrorResult("random_card", err), nil
+	}
+
+	response := randomCardResponse{Cards: cards, SchemaVersion: schemaVersions["random_card"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(cards, ",")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomCards securely shuffles a fresh deck and deals the top count cards, so no card appears
+// twice in one call. With jokers set the deck holds 54 cards instead of 52.
+func randomCards(ctx context.Context, count int, jokers bool) ([]string, error) {
+	deck := newDeck(jokers)
+	if count < 1 || count > len(deck) {
+		return nil, fmt.Errorf("count must be between 1 and %d", len(deck))
+	}
+
+	shuffled, err := shuffledCopy(ctx, deck)
+	if err != nil {
+		return nil, err
+	}
+	return shuffled[:count], nil
+}
+
+// newDeck returns the cards of a standard deck in suit order, optionally followed by the jokers.
+func newDeck(jokers bool) []string {
+	deck := make([]string, 0, len(cardRanks)*len(cardSuits)+len(cardJokers))
+	for _, suit := range cardSuits {
+		for _, rank := range cardRanks {
+			deck = append(deck, rank+suit)
+		}
+	}
+	if jokers {
+		deck = append(deck, cardJokers...)
+	}
+	return deck
+}
@@ -0,0 +1,114 @@
+package random
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomCardHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		request   mcp.CallToolRequest
+		wantCount int
+		jokers    bool
+		wantErr   bool
+	}{
+		{
+			desc:      "valid request with no args",
+			request:   mcp.CallToolRequest{},
+			wantCount: 1,
+		},
+		{
+			desc:      "valid request for a hand",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 5}}},
+			wantCount: 5,
+		},
+		{
+			desc:      "valid request for the whole deck",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 52}}},
+			wantCount: 52,
+		},
+		{
+			desc:      "valid request for the whole deck with jokers",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 54, "jokers": true}}},
+			wantCount: 54,
+			jokers:    true,
+		},
+		{
+			desc:    "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with more cards than the deck",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 53}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomCardHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomCardHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomCardHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomCardHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomCardHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomCardResponse)
+			if !ok {
+				t.Fatalf("randomCardHandler() structured content type = %T, want randomCardResponse", result.StructuredContent)
+			}
+			if len(structured.Cards) != tc.wantCount {
+				t.Fatalf("randomCardHandler() returned %d cards, want %d", len(structured.Cards), tc.wantCount)
+			}
+			deck := make(map[string]bool)
+			for _, card := range newDeck(tc.jokers) {
+				deck[card] = true
+			}
+			seen := make(map[string]bool)
+			for _, card := range structured.Cards {
+				if !deck[card] {
+					t.Fatalf("randomCardHandler() returned unknown card %q", card)
+				}
+				if seen[card] {
+					t.Fatalf("randomCardHandler() returned %q twice", card)
+				}
+				seen[card] = true
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != strings.Join(structured.Cards, ",") {
+				t.Fatalf("randomCardHandler() text content = %+v, want comma-separated cards", result.Content[0])
+			}
+		})
+	}
+}
+
+func TestNewDeck(t *testing.T) {
+	deck := newDeck(false)
+	if len(deck) != 52 {
+		t.Fatalf("newDeck(false) has %d cards, want 52", len(deck))
+	}
+	for _, card := range []string{"AS", "10H", "KD", "2C"} {
+		if !slices.Contains(deck, card) {
+			t.Fatalf("newDeck(false) missing %q", card)
+		}
+	}
+	if withJokers := newDeck(true); len(withJokers) != 54 || withJokers[52] != "BJ" || withJokers[53] != "RJ" {
+		t.Fatalf("newDeck(true) = %v, want the standard deck followed by BJ and RJ", withJokers)
+	}
+}
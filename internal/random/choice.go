@@ -0,0 +1,155 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// randomChoiceResponse reports the chosen item's index in the original items, and the number
+// of candidates left to choose from once exclude was applied.
+type randomChoiceResponse struct {
+	Value         string  `json:"value"`
+	Index         int     `json:"index"`
+	Probability   float64 `json:"probability"`
+	Candidates    int     `json:"candidates"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+// randomChoiceArgs may list previously returned items in Exclude, so callers can avoid
+// repeats across calls without the server keeping any session state.
+type randomChoiceArgs struct {
+	Items   []string  `json:"items"`
+	Weights []float64 `json:"weights,omitempty"`
+	Exclude []string  `json:"exclude,omitempty"`
+}
+
+func randomChoiceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomChoiceArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_choice", err), nil
+	}
+
+	candidates, err := choiceCandidates(args.Items, args.Weights, args.Exclude)
+	if err != nil {
+		return toolErrorResult("random_choice", err), nil
+	}
+
+	var pick int
+	var probability float64
+	if args.Weights != nil {
+		weights := make([]float64, len(candidates))
+		for i, index := range candidates {
+			weights[i] = args.Weights[index]
+		}
+		pick, probability, err = randomWeightedIndex(ctx, len(candidates), weights)
+	} else {
+		pick, err = randomIndex(ctx, len(candidates))
+		probability = 1 / float64(len(candidates))
+	}
+	if err != nil {
+		return toolErrorResult("random_choice", err), nil
+	}
+
+	index := candidates[pick]
+	value := args.Items[index]
+	response := randomChoiceResponse{Value: value, Index: index, Probability: probability, Candidates: len(candidates), SchemaVersion: schemaVersions["random_choice"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// choiceCandidates returns the indices of the items that are not in exclude, in order.
+// Weights, when given, must have one entry per item. It fails when exclude removes every item.
+func choiceCandidates(items []string, weights []float64, exclude []string) ([]int, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("items must not be empty")
+	}
+	if weights != nil && len(weights) != len(items) {
+		return nil, fmt.Errorf("weights length %d must equal items length %d", len(weights), len(items))
+	}
+
+	excluded := make(map[string]bool, len(exclude))
+	for _, item := range exclude {
+		excluded[item] = true
+	}
+	candidates := make([]int, 0, len(items))
+	for i, item := range items {
+		if !excluded[item] {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("all %d items are excluded", len(items))
+	}
+	return candidates, nil
+}
+
+// randomIndex returns a cryptographically secure random index in [0, size-1].
+// Size must be greater than zero.
+func randomIndex(ctx context.Context, size int) (int, error) {
+	if size <= 0 {
+		return 0, fmt.Errorf("items must not be empty")
+	}
+
+	index, err := sourceFromContext(ctx).Int64(0, int64(size-1))
+	if err != nil {
+		return 0, err
+	}
+	return int(index), nil
+}
+
+// randomWeightedIndex returns an index in [0, size-1] selected in proportion to weights,
+// along with the normalized probability of the selected index. Weights must have one
+// non-negative, finite entry per item and a positive sum.
+func randomWeightedIndex(ctx context.Context, size int, weights []float64) (int, float64, error) {
+	if size <= 0 {
+		return 0, 0, fmt.Errorf("items must not be empty")
+	}
+	if len(weights) != size {
+		return 0, 0, fmt.Errorf("weights length %d must equal items length %d", len(weights), size)
+	}
+
+	cumulative := make([]float64, size)
+	total := 0.0
+	for i, weight := range weights {
+		if math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return 0, 0, fmt.Errorf("weights must be finite")
+		}
+		if weight < 0 {
+			return 0, 0, fmt.Errorf("weights must not be negative")
+		}
+		total += weight
+		cumulative[i] = total
+	}
+	if math.IsInf(total, 0) {
+		return 0, 0, fmt.Errorf("sum of weights must be finite")
+	}
+	if total <= 0 {
+		return 0, 0, fmt.Errorf("sum of weights must be greater than zero")
+	}
+
+	unit, err := sourceFromContext(ctx).UnitFloat64()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	target := unit * total
+	index := -1
+	for i, bound := range cumulative {
+		if weights[i] > 0 {
+			index = i
+			if target < bound {
+				break
+			}
+		}
+	}
+
+	return index, weights[index] / total, nil
+}
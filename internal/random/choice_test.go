@@ -0,0 +1,231 @@
+package random
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomChoiceHandler(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		request     mcp.CallToolRequest
+		items       []string
+		wantIndex   int
+		probability float64
+		wantErr     bool
+	}{
+		{
+			desc:    "invalid request with missing items",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with empty items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{}}}},
+			wantErr: true,
+		},
+		{
+			desc:        "valid request with single item",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"only"}}}},
+			items:       []string{"only"},
+			wantIndex:   0,
+			probability: 1,
+		},
+		{
+			desc:        "valid request with several items",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"red", "green", "blue"}}}},
+			items:       []string{"red", "green", "blue"},
+			wantIndex:   -1,
+			probability: 1.0 / 3,
+		},
+		{
+			desc: "valid request with weights selecting a single item",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"items":   []string{"red", "green", "blue"},
+				"weights": []float64{0, 2.5, 0},
+			}}},
+			items:       []string{"red", "green", "blue"},
+			wantIndex:   1,
+			probability: 1,
+		},
+		{
+			desc: "valid request with equal weights",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"items":   []string{"heads", "tails"},
+				"weights": []float64{1, 1},
+			}}},
+			items:       []string{"heads", "tails"},
+			wantIndex:   -1,
+			probability: 0.5,
+		},
+		{
+			desc: "invalid request with mismatched weights length",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"items":   []string{"red", "green", "blue"},
+				"weights": []float64{1, 2},
+			}}},
+			wantErr: true,
+		},
+		{
+			desc: "invalid request with negative weight",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"items":   []string{"red", "green"},
+				"weights": []float64{1, -1},
+			}}},
+			wantErr: true,
+		},
+		{
+			desc: "invalid request with zero weight sum",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"items":   []string{"red", "green"},
+				"weights": []float64{0, 0},
+			}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomChoiceHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomChoiceHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomChoiceHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomChoiceHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomChoiceHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomChoiceHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomChoiceResponse)
+			if !ok {
+				t.Fatalf("randomChoiceHandler() structured content type = %T, want randomChoiceResponse", result.StructuredContent)
+			}
+			if structured.Index < 0 || structured.Index >= len(tc.items) {
+				t.Fatalf("randomChoiceHandler() index out of range: %d", structured.Index)
+			}
+			if tc.wantIndex >= 0 && structured.Index != tc.wantIndex {
+				t.Fatalf("randomChoiceHandler() index = %d, want %d", structured.Index, tc.wantIndex)
+			}
+			if structured.Probability != tc.probability {
+				t.Fatalf("randomChoiceHandler() probability = %f, want %f", structured.Probability, tc.probability)
+			}
+			if structured.Value != tc.items[structured.Index] {
+				t.Fatalf("randomChoiceHandler() structured value %q != item at index %d", structured.Value, structured.Index)
+			}
+			if structured.Value != textContent.Text {
+				t.Fatalf("randomChoiceHandler() structured value != text value")
+			}
+		})
+	}
+}
+
+func TestRandomWeightedIndexRejectsNonFinite(t *testing.T) {
+	for _, weights := range [][]float64{{1, math.NaN()}, {math.Inf(1), 1}, {math.MaxFloat64, math.MaxFloat64}} {
+		if _, _, err := randomWeightedIndex(t.Context(), len(weights), weights); err == nil {
+			t.Fatalf("randomWeightedIndex(%v) expected error, got nil", weights)
+		}
+	}
+}
+
+func TestRandomChoiceHandlerExclude(t *testing.T) {
+	items := []string{"red", "green", "blue", "yellow"}
+	testCases := []struct {
+		desc           string
+		args           map[string]any
+		wantIndices    []int
+		wantCandidates int
+		probability    float64
+		wantErr        bool
+	}{
+		{
+			desc:           "partial exclusion",
+			args:           map[string]any{"items": items, "exclude": []string{"red", "blue"}},
+			wantIndices:    []int{1, 3},
+			wantCandidates: 2,
+			probability:    0.5,
+		},
+		{
+			desc:           "exclusion leaving one item",
+			args:           map[string]any{"items": items, "exclude": []string{"red", "green", "yellow"}},
+			wantIndices:    []int{2},
+			wantCandidates: 1,
+			probability:    1,
+		},
+		{
+			desc:           "unknown exclusions are ignored",
+			args:           map[string]any{"items": items, "exclude": []string{"purple"}},
+			wantIndices:    []int{0, 1, 2, 3},
+			wantCandidates: 4,
+			probability:    0.25,
+		},
+		{
+			desc:           "exclusion with weights renormalizes",
+			args:           map[string]any{"items": items, "weights": []float64{1, 1, 2, 8}, "exclude": []string{"yellow"}},
+			wantIndices:    []int{0, 1, 2},
+			wantCandidates: 3,
+		},
+		{
+			desc:    "full exclusion",
+			args:    map[string]any{"items": items, "exclude": items},
+			wantErr: true,
+		},
+		{
+			desc:    "weights must still match items",
+			args:    map[string]any{"items": items, "weights": []float64{1, 1, 1}, "exclude": []string{"yellow"}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			// Repeat the draw so an excluded item has many chances to slip through.
+			for range 50 {
+				result, err := randomChoiceHandler(ctx, request)
+				if err != nil {
+					t.Fatalf("randomChoiceHandler() error = %v", err)
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomChoiceHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomChoiceHandler() returned error content: %+v", result.Content[0])
+				}
+
+				structured, ok := result.StructuredContent.(randomChoiceResponse)
+				if !ok {
+					t.Fatalf("randomChoiceHandler() structured content type = %T, want randomChoiceResponse", result.StructuredContent)
+				}
+				if !slices.Contains(tc.wantIndices, structured.Index) || structured.Value != items[structured.Index] {
+					t.Fatalf("randomChoiceHandler() chose %q at index %d, want one of indices %v", structured.Value, structured.Index, tc.wantIndices)
+				}
+				if structured.Candidates != tc.wantCandidates {
+					t.Fatalf("randomChoiceHandler() candidates = %d, want %d", structured.Candidates, tc.wantCandidates)
+				}
+				if tc.probability != 0 && structured.Probability != tc.probability {
+					t.Fatalf("randomChoiceHandler() probability = %g, want %g", structured.Probability, tc.probability)
+				}
+			}
+		})
+	}
+}
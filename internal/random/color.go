@@ -0,0 +1,94 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomColorResponse struct {
+	Hex           string `json:"hex"`
+	R             int    `json:"r"`
+	G             int    `json:"g"`
+	B             int    `json:"b"`
+	Format        string `json:"format"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomColorArgs struct {
+	Format string `json:"format,omitempty"`
+}
+
+func randomColorHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomColorArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_color", err), nil
+	}
+
+	format := "hex"
+	if args.Format != "" {
+		format = args.Format
+	}
+	if format != "hex" && format != "rgb" && format != "hsl" {
+		return toolErrorResult("random_color", fmt.Errorf("unsupported format %q: must be hex, rgb, or hsl", format)), nil
+	}
+
+	var rgb [3]byte
+	if _, err := sourceFromContext(ctx).Read(rgb[:]); err != nil {
+		return toolErrorResult("random_color", err), nil
+	}
+
+	r, g, b := int(rgb[0]), int(rgb[1]), int(rgb[2])
+	hex := fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	text := hex
+	switch format {
+	case "rgb":
+		text = fmt.Sprintf("rgb(%d, %d, %d)", r, g, b)
+	case "hsl":
+		h, s, l := rgbToHSL(rgb[0], rgb[1], rgb[2])
+		text = fmt.Sprintf("hsl(%d, %d%%, %d%%)", h, s, l)
+	}
+
+	response := randomColorResponse{Hex: hex, R: r, G: g, B: b, Format: format, SchemaVersion: schemaVersions["random_color"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: text},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// rgbToHSL converts an RGB color to hue in degrees [0, 360) and saturation and lightness
+// as whole percentages.
+func rgbToHSL(r, g, b byte) (int, int, int) {
+	rf := float64(r) / 255
+	gf := float64(g) / 255
+	bf := float64(b) / 255
+	maxC := math.Max(rf, math.Max(gf, bf))
+	minC := math.Min(rf, math.Min(gf, bf))
+	delta := maxC - minC
+
+	l := (maxC + minC) / 2
+	var h, s float64
+	if delta != 0 {
+		s = delta / (1 - math.Abs(2*l-1))
+		switch maxC {
+		case rf:
+			h = math.Mod((gf-bf)/delta, 6)
+		case gf:
+			h = (bf-rf)/delta + 2
+		default:
+			h = (rf-gf)/delta + 4
+		}
+		h *= 60
+		if h < 0 {
+			h += 360
+		}
+	}
+
+	hue := int(math.Round(h)) % 360
+	return hue, int(math.Round(s * 100)), int(math.Round(l * 100))
+}
@@ -0,0 +1,106 @@
+package random
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomColorHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		format  string
+		pattern *regexp.Regexp
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with default format",
+			request: mcp.CallToolRequest{},
+			format:  "hex",
+			pattern: regexp.MustCompile(`^#[0-9a-f]{6}$`),
+		},
+		{
+			desc:    "valid request with rgb format",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"format": "rgb"}}},
+			format:  "rgb",
+			pattern: regexp.MustCompile(`^rgb\(\d{1,3}, \d{1,3}, \d{1,3}\)$`),
+		},
+		{
+			desc:    "valid request with hsl format",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"format": "hsl"}}},
+			format:  "hsl",
+			pattern: regexp.MustCompile(`^hsl\(\d{1,3}, \d{1,3}%, \d{1,3}%\)$`),
+		},
+		{
+			desc:    "invalid request with unknown format",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"format": "cmyk"}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomColorHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomColorHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomColorHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomColorHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomColorHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomColorHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			if !tc.pattern.MatchString(textContent.Text) {
+				t.Fatalf("randomColorHandler() text %q does not match %s", textContent.Text, tc.pattern)
+			}
+
+			structured, ok := result.StructuredContent.(randomColorResponse)
+			if !ok {
+				t.Fatalf("randomColorHandler() structured content type = %T, want randomColorResponse", result.StructuredContent)
+			}
+			if structured.Format != tc.format {
+				t.Fatalf("randomColorHandler() structured format = %q, want %q", structured.Format, tc.format)
+			}
+			if want := fmt.Sprintf("#%02x%02x%02x", structured.R, structured.G, structured.B); structured.Hex != want {
+				t.Fatalf("randomColorHandler() structured hex = %q, want %q", structured.Hex, want)
+			}
+		})
+	}
+}
+
+func TestRGBToHSL(t *testing.T) {
+	testCases := []struct {
+		r, g, b byte
+		h, s, l int
+	}{
+		{r: 0, g: 0, b: 0, h: 0, s: 0, l: 0},
+		{r: 255, g: 255, b: 255, h: 0, s: 0, l: 100},
+		{r: 255, g: 0, b: 0, h: 0, s: 100, l: 50},
+		{r: 0, g: 255, b: 0, h: 120, s: 100, l: 50},
+		{r: 0, g: 0, b: 255, h: 240, s: 100, l: 50},
+		{r: 255, g: 0, b: 255, h: 300, s: 100, l: 50},
+		{r: 128, g: 128, b: 128, h: 0, s: 0, l: 50},
+		{r: 161, g: 178, b: 195, h: 210, s: 22, l: 70},
+	}
+	for _, tc := range testCases {
+		h, s, l := rgbToHSL(tc.r, tc.g, tc.b)
+		if h != tc.h || s != tc.s || l != tc.l {
+			t.Fatalf("rgbToHSL(%d, %d, %d) = (%d, %d, %d), want (%d, %d, %d)", tc.r, tc.g, tc.b, h, s, l, tc.h, tc.s, tc.l)
+		}
+	}
+}
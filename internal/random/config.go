@@ -0,0 +1,177 @@
+package random
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultMaxASCIILength caps random_ascii output unless overridden with WithMaxASCIILength.
+const defaultMaxASCIILength = 1 << 20
+
+// defaultHandlerTimeout bounds each tool call unless overridden with WithHandlerTimeout.
+const defaultHandlerTimeout = 30 * time.Second
+
+// config holds server-wide settings. NewMCPServer attaches it to every tool call's
+// context so handlers can read it with configFromContext.
+type config struct {
+	maxASCIILength int
+	defaultIntMin  int64
+	defaultIntMax  int64
+	// handlerTimeout bounds each tool call; zero disables the limit.
+	handlerTimeout time.Duration
+	// maxConcurrentHeavy bounds how many heavyTools calls run at once; zero disables the limit.
+	maxConcurrentHeavy int
+	// inFlight, when non-nil, counts the tool handlers currently running.
+	inFlight *atomic.Int64
+	// source, when non-nil, replaces crypto/rand.Reader as every generator's entropy source.
+	source *securerand.Rand
+	// enabledTools, when non-nil, is the allowlist of tools to register.
+	enabledTools  map[string]bool
+	disabledTools map[string]bool
+}
+
+func defaultConfig() config {
+	return config{
+		maxASCIILength: defaultMaxASCIILength,
+		defaultIntMin:  0,
+		defaultIntMax:  math.MaxInt64,
+		handlerTimeout: defaultHandlerTimeout,
+	}
+}
+
+// Option customizes the server built by NewMCPServer.
+type Option func(*config)
+
+// WithMaxASCIILength sets the longest string random_ascii will generate.
+// Non-positive values keep the default of 1 MiB.
+func WithMaxASCIILength(length int) Option {
+	return func(c *config) {
+		if length > 0 {
+			c.maxASCIILength = length
+		}
+	}
+}
+
+// WithDefaultIntMin sets the lower bound random_int uses when a call omits min.
+// The built-in default is 0.
+func WithDefaultIntMin(min int64) Option {
+	return func(c *config) {
+		c.defaultIntMin = min
+	}
+}
+
+// WithDefaultIntMax sets the upper bound random_int uses when a call omits max.
+// The built-in default is math.MaxInt64.
+func WithDefaultIntMax(max int64) Option {
+	return func(c *config) {
+		c.defaultIntMax = max
+	}
+}
+
+// WithHandlerTimeout sets how long a single tool call may run before it fails with a
+// deadline-exceeded error. Zero disables the limit; negative values keep the default of 30s.
+func WithHandlerTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		if timeout >= 0 {
+			c.handlerTimeout = timeout
+		}
+	}
+}
+
+// WithMaxConcurrentHeavy lets at most limit calls to CPU-intensive tools, such as random_prime
+// and random_permutation, run at once. Calls beyond the limit fail with a SERVER_BUSY error
+// instead of waiting. Non-positive values disable the limit, which is the default.
+func WithMaxConcurrentHeavy(limit int) Option {
+	return func(c *config) {
+		c.maxConcurrentHeavy = max(0, limit)
+	}
+}
+
+// WithInFlightCounter makes the server keep counter equal to the number of tool handlers
+// currently running, so the caller can report how many calls a shutdown is waiting on. A
+// handler abandoned by the handler timeout stays counted until it actually returns.
+func WithInFlightCounter(counter *atomic.Int64) Option {
+	return func(c *config) {
+		c.inFlight = counter
+	}
+}
+
+// WithRandReader makes every tool draw its entropy from reader instead of crypto/rand.Reader,
+// for example a hardware RNG or HSM exposed as an io.Reader, or a fixed stream in tests.
+// Output is only as unpredictable as reader. A nil reader keeps the default.
+func WithRandReader(reader io.Reader) Option {
+	return func(c *config) {
+		if reader != nil {
+			c.source = securerand.New(reader)
+		}
+	}
+}
+
+// WithToolsEnabled registers only the named tools. Tools not listed are left out of the
+// server entirely, so clients never see them in tools/list.
+func WithToolsEnabled(names ...string) Option {
+	return func(c *config) {
+		c.enabledTools = make(map[string]bool, len(names))
+		for _, name := range names {
+			c.enabledTools[name] = true
+		}
+	}
+}
+
+// WithToolsDisabled leaves the named tools out of the server. It takes precedence over
+// WithToolsEnabled.
+func WithToolsDisabled(names ...string) Option {
+	return func(c *config) {
+		if c.disabledTools == nil {
+			c.disabledTools = make(map[string]bool, len(names))
+		}
+		for _, name := range names {
+			c.disabledTools[name] = true
+		}
+	}
+}
+
+// toolEnabled reports whether NewMCPServer should register the named tool.
+func (c config) toolEnabled(name string) bool {
+	if c.disabledTools[name] {
+		return false
+	}
+	return c.enabledTools == nil || c.enabledTools[name]
+}
+
+type configKey struct{}
+
+// withConfig returns a copy of ctx carrying cfg.
+func withConfig(ctx context.Context, cfg config) context.Context {
+	return context.WithValue(ctx, configKey{}, cfg)
+}
+
+// configFromContext returns the config attached by withConfig, or the defaults when
+// the handler is invoked outside NewMCPServer (for example, directly from tests).
+func configFromContext(ctx context.Context) config {
+	if cfg, ok := ctx.Value(configKey{}).(config); ok {
+		return cfg
+	}
+	return defaultConfig()
+}
+
+// configMiddleware attaches cfg to the context of every tool call, along with its entropy
+// source when one was configured.
+func configMiddleware(cfg config) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx = withConfig(ctx, cfg)
+			if cfg.source != nil {
+				ctx = withSource(ctx, cfg.source)
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package random
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestConfigFromContext(t *testing.T) {
+	if got := configFromContext(t.Context()); !reflect.DeepEqual(got, defaultConfig()) {
+		t.Fatalf("configFromContext() without config = %+v, want defaults %+v", got, defaultConfig())
+	}
+
+	cfg := config{maxASCIILength: 8}
+	if got := configFromContext(withConfig(t.Context(), cfg)); !reflect.DeepEqual(got, cfg) {
+		t.Fatalf("configFromContext() = %+v, want %+v", got, cfg)
+	}
+}
+
+func TestWithMaxASCIILength(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		length int
+		want   int
+	}{
+		{desc: "positive length", length: 64, want: 64},
+		{desc: "zero keeps default", length: 0, want: defaultMaxASCIILength},
+		{desc: "negative keeps default", length: -1, want: defaultMaxASCIILength},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := defaultConfig()
+			WithMaxASCIILength(tc.length)(&cfg)
+			if cfg.maxASCIILength != tc.want {
+				t.Fatalf("WithMaxASCIILength(%d) max = %d, want %d", tc.length, cfg.maxASCIILength, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewMCPServerAppliesOptions(t *testing.T) {
+	mcpServer := NewMCPServer("test-server", "0.0.0", WithMaxASCIILength(8))
+
+	testCases := []struct {
+		desc    string
+		length  int
+		wantErr bool
+	}{
+		{desc: "within configured max", length: 8},
+		{desc: "over configured max", length: 9, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			message := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_ascii","arguments":{"length":%d}}}`, tc.length)
+			response := mcpServer.HandleMessage(t.Context(), json.RawMessage(message))
+
+			rpcResponse, ok := response.(mcp.JSONRPCResponse)
+			if !ok {
+				t.Fatalf("HandleMessage() response type = %T, want JSONRPCResponse", response)
+			}
+			result, ok := rpcResponse.Result.(mcp.CallToolResult)
+			if !ok {
+				t.Fatalf("HandleMessage() result type = %T, want CallToolResult", rpcResponse.Result)
+			}
+			if result.IsError != tc.wantErr {
+				t.Fatalf("random_ascii length %d IsError = %v, want %v: %+v", tc.length, result.IsError, tc.wantErr, result.Content)
+			}
+		})
+	}
+}
+
+func TestNewMCPServerWithRandReader(t *testing.T) {
+	// Two servers reading the same fixed stream must produce the same values.
+	call := func(t *testing.T, seed [32]byte) string {
+		mcpServer := NewMCPServer("test-server", "0.0.0", WithRandReader(rand.NewChaCha8(seed)))
+		message := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int","arguments":{"min":0,"max":1000000000,"count":5}}}`
+		response := mcpServer.HandleMessage(t.Context(), json.RawMessage(message))
+		rpcResponse, ok := response.(mcp.JSONRPCResponse)
+		if !ok {
+			t.Fatalf("HandleMessage() response type = %T, want JSONRPCResponse", response)
+		}
+		result, ok := rpcResponse.Result.(mcp.CallToolResult)
+		if !ok || result.IsError {
+			t.Fatalf("HandleMessage() result = %+v, want success", rpcResponse.Result)
+		}
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	seed := [32]byte{1, 2, 3}
+	first, second := call(t, seed), call(t, seed)
+	if first != second {
+		t.Fatalf("random_int with the same reader returned %q and %q, want identical output", first, second)
+	}
+	if other := call(t, [32]byte{4, 5, 6}); other == first {
+		t.Fatalf("random_int with a different reader returned the same output %q", other)
+	}
+
+	// A reader with no bytes left must surface as a failed call rather than crypto/rand output.
+	mcpServer := NewMCPServer("test-server", "0.0.0", WithRandReader(bytes.NewReader(nil)))
+	response := mcpServer.HandleMessage(t.Context(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_float"}}`))
+	if result, ok := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult); !ok || !result.IsError {
+		t.Fatalf("random_float with an empty reader = %+v, want error result", response)
+	}
+}
+
+func TestNewMCPServerAppliesDefaultIntBounds(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		opts    []Option
+		args    string
+		wantMin int64
+		wantMax int64
+	}{
+		{desc: "built-in defaults", args: `{}`, wantMin: 0, wantMax: math.MaxInt64},
+		{desc: "custom max", opts: []Option{WithDefaultIntMax(100)}, args: `{}`, wantMin: 0, wantMax: 100},
+		{desc: "custom min and max", opts: []Option{WithDefaultIntMin(-10), WithDefaultIntMax(10)}, args: `{}`, wantMin: -10, wantMax: 10},
+		{desc: "explicit max overrides default", opts: []Option{WithDefaultIntMax(100)}, args: `{"max":5}`, wantMin: 0, wantMax: 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mcpServer := NewMCPServer("test-server", "0.0.0", tc.opts...)
+			message := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int","arguments":%s}}`, tc.args)
+			response := mcpServer.HandleMessage(t.Context(), json.RawMessage(message))
+
+			rpcResponse, ok := response.(mcp.JSONRPCResponse)
+			if !ok {
+				t.Fatalf("HandleMessage() response type = %T, want JSONRPCResponse", response)
+			}
+			result, ok := rpcResponse.Result.(mcp.CallToolResult)
+			if !ok {
+				t.Fatalf("HandleMessage() result type = %T, want CallToolResult", rpcResponse.Result)
+			}
+			if result.IsError {
+				t.Fatalf("random_int returned error content: %+v", result.Content)
+			}
+			audited, ok := result.StructuredContent.(auditedResponse)
+			if !ok {
+				t.Fatalf("random_int structured content type = %T, want auditedResponse", result.StructuredContent)
+			}
+			structured, ok := audited.response.(randomIntResponse)
+			if !ok {
+				t.Fatalf("random_int audited response type = %T, want randomIntResponse", audited.response)
+			}
+			if structured.EffectiveMin != tc.wantMin || structured.EffectiveMax != tc.wantMax {
+				t.Fatalf("random_int effective range = [%d, %d], want [%d, %d]", structured.EffectiveMin, structured.EffectiveMax, tc.wantMin, tc.wantMax)
+			}
+			if structured.Value < tc.wantMin || structured.Value > tc.wantMax {
+				t.Fatalf("random_int value = %d, outside [%d, %d]", structured.Value, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
+func TestNewMCPServerToolSelection(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		opts    []Option
+		present []string
+		absent  []string
+	}{
+		{
+			desc:    "all tools by default",
+			present: []string{"random_int", "random_ascii", "random_float"},
+		},
+		{
+			desc:    "disabled tool is not registered",
+			opts:    []Option{WithToolsDisabled("random_ascii")},
+			present: []string{"random_int", "random_float"},
+			absent:  []string{"random_ascii"},
+		},
+		{
+			desc:    "integer-only allowlist",
+			opts:    []Option{WithToolsEnabled("random_int")},
+			present: []string{"random_int"},
+			absent:  []string{"random_ascii", "random_float"},
+		},
+		{
+			desc:    "disable wins over enable",
+			opts:    []Option{WithToolsEnabled("random_int", "random_ascii"), WithToolsDisabled("random_ascii")},
+			present: []string{"random_int"},
+			absent:  []string{"random_ascii", "random_float"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tools := NewMCPServer("test-server", "0.0.0", tc.opts...).ListTools()
+			for _, name := range tc.present {
+				if _, ok := tools[name]; !ok {
+					t.Fatalf("ListTools() missing %s", name)
+				}
+			}
+			for _, name := range tc.absent {
+				if _, ok := tools[name]; ok {
+					t.Fatalf("ListTools() includes disabled tool %s", name)
+				}
+			}
+		})
+	}
+
+	if got := len(NewMCPServer("test-server", "0.0.0", WithToolsEnabled("random_int")).ListTools()); got != 1 {
+		t.Fatalf("ListTools() with a single enabled tool returned %d tools, want 1", got)
+	}
+}
@@ -0,0 +1,108 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomDateResponse struct {
+	Value         string `json:"value"`
+	UnixSeconds   int64  `json:"unixSeconds"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomDateArgs struct {
+	Start       string `json:"start"`
+	End         string `json:"end"`
+	Granularity string `json:"granularity,omitempty"`
+}
+
+func randomDateHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomDateArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_date", err), nil
+	}
+
+	granularity := "second"
+	if args.Granularity != "" {
+		granularity = args.Granularity
+	}
+
+	value, err := randomDate(ctx, args.Start, args.End, granularity)
+	if err != nil {
+		return toolErrorResult("random_date", err), nil
+	}
+
+	formatted := value.Format(time.RFC3339)
+	response := randomDateResponse{Value: formatted, UnixSeconds: value.Unix(), SchemaVersion: schemaVersions["random_date"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: formatted},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomDate returns a uniformly random time in [start, end], both RFC3339 timestamps. The
+// result is a whole number of granularity units (second, day, or month) after start and is
+// expressed in start's time zone.
+func randomDate(ctx context.Context, start, end, granularity string) (time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("start must be an RFC3339 timestamp: %w", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("end must be an RFC3339 timestamp: %w", err)
+	}
+	if startTime.After(endTime) {
+		return time.Time{}, fmt.Errorf("start cannot be after end")
+	}
+	span := endTime.Unix() - startTime.Unix()
+
+	switch granularity {
+	case "second":
+		offset, err := sourceFromContext(ctx).Int64(0, span)
+		if err != nil {
+			return time.Time{}, err
+		}
+		// Add whole seconds to the Unix time: a time.Duration overflows for spans past
+		// about 292 years. start's nanoseconds can carry the result just past end.
+		value := time.Unix(startTime.Unix()+offset, int64(startTime.Nanosecond())).In(startTime.Location())
+		if value.After(endTime) {
+			value = endTime.In(startTime.Location())
+		}
+		return value, nil
+	case "day":
+		days, err := sourceFromContext(ctx).Int64(0, span/(24*60*60))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return startTime.AddDate(0, 0, int(days)), nil
+	case "month":
+		months := (endTime.Year()-startTime.Year())*12 + int(endTime.Month()-startTime.Month())
+		for months > 0 && addMonths(startTime, months).After(endTime) {
+			months--
+		}
+		offset, err := sourceFromContext(ctx).Int64(0, int64(months))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return addMonths(startTime, int(offset)), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported granularity %q: must be second, day, or month", granularity)
+	}
+}
+
+// addMonths returns t moved by months calendar months, keeping its time of day. Unlike
+// t.AddDate, a day past the end of the target month is clamped to that month's last day,
+// so Jan 31 plus one month is Feb 28 or 29 rather than a day in March.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
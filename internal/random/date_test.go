@@ -0,0 +1,165 @@
+package random
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomDateHandler(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		args        map[string]any
+		start       string
+		end         string
+		granularity string
+		wantErr     bool
+	}{
+		{
+			desc:  "valid request with default granularity",
+			args:  map[string]any{"start": "2024-01-01T00:00:00Z", "end": "2024-12-31T23:59:59Z"},
+			start: "2024-01-01T00:00:00Z",
+			end:   "2024-12-31T23:59:59Z",
+		},
+		{
+			desc:  "valid request with equal start and end",
+			args:  map[string]any{"start": "2024-02-29T12:00:00Z", "end": "2024-02-29T12:00:00Z"},
+			start: "2024-02-29T12:00:00Z",
+			end:   "2024-02-29T12:00:00Z",
+		},
+		{
+			desc:  "valid request spanning several centuries",
+			args:  map[string]any{"start": "1000-01-01T00:00:00Z", "end": "3000-01-01T00:00:00Z"},
+			start: "1000-01-01T00:00:00Z",
+			end:   "3000-01-01T00:00:00Z",
+		},
+		{
+			desc:  "valid request with fractional start clamped to end",
+			args:  map[string]any{"start": "2024-01-01T00:00:00.5Z", "end": "2024-01-01T00:00:01Z"},
+			start: "2024-01-01T00:00:00Z",
+			end:   "2024-01-01T00:00:01Z",
+		},
+		{
+			desc:        "valid request with day granularity",
+			args:        map[string]any{"start": "2024-03-01T09:30:00-05:00", "end": "2024-03-20T08:00:00-05:00", "granularity": "day"},
+			start:       "2024-03-01T09:30:00-05:00",
+			end:         "2024-03-20T08:00:00-05:00",
+			granularity: "day",
+		},
+		{
+			desc:        "valid request with month granularity",
+			args:        map[string]any{"start": "2023-01-15T00:00:00Z", "end": "2024-06-10T00:00:00Z", "granularity": "month"},
+			start:       "2023-01-15T00:00:00Z",
+			end:         "2024-06-10T00:00:00Z",
+			granularity: "month",
+		},
+		{
+			desc:        "valid request with month granularity from the 31st",
+			args:        map[string]any{"start": "2024-01-31T00:00:00Z", "end": "2024-12-31T00:00:00Z", "granularity": "month"},
+			start:       "2024-01-31T00:00:00Z",
+			end:         "2024-12-31T00:00:00Z",
+			granularity: "month",
+		},
+		{
+			desc:    "invalid request with start after end",
+			args:    map[string]any{"start": "2025-01-01T00:00:00Z", "end": "2024-01-01T00:00:00Z"},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with malformed start",
+			args:    map[string]any{"start": "2024-01-01", "end": "2024-01-02T00:00:00Z"},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with missing end",
+			args:    map[string]any{"start": "2024-01-01T00:00:00Z"},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with unknown granularity",
+			args:    map[string]any{"start": "2024-01-01T00:00:00Z", "end": "2024-01-02T00:00:00Z", "granularity": "week"},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			for i := 0; i < 20; i++ {
+				result, err := randomDateHandler(ctx, request)
+				if err != nil {
+					t.Fatalf("randomDateHandler() error = %v", err)
+				}
+				if result == nil || len(result.Content) == 0 {
+					t.Fatalf("randomDateHandler() result is nil or empty")
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomDateHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomDateHandler() returned error content: %+v", result.Content[0])
+				}
+
+				textContent, ok := result.Content[0].(mcp.TextContent)
+				if !ok {
+					t.Fatalf("randomDateHandler() content type = %T, want TextContent", result.Content[0])
+				}
+				value, err := time.Parse(time.RFC3339, textContent.Text)
+				if err != nil {
+					t.Fatalf("randomDateHandler() invalid text content: %v", err)
+				}
+
+				start, _ := time.Parse(time.RFC3339, tc.start)
+				end, _ := time.Parse(time.RFC3339, tc.end)
+				if value.Before(start) || value.After(end) {
+					t.Fatalf("randomDateHandler() value %s outside [%s, %s]", value, start, end)
+				}
+				switch tc.granularity {
+				case "day":
+					if value.Hour() != start.Hour() || value.Minute() != start.Minute() || value.Second() != start.Second() {
+						t.Fatalf("randomDateHandler() value %s is not a whole number of days after %s", value, start)
+					}
+				case "month":
+					lastDay := time.Date(value.Year(), value.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+					if value.Day() != min(start.Day(), lastDay) || !value.Truncate(24*time.Hour).Equal(value) {
+						t.Fatalf("randomDateHandler() value %s is not a whole number of months after %s", value, start)
+					}
+				}
+
+				structured, ok := result.StructuredContent.(randomDateResponse)
+				if !ok {
+					t.Fatalf("randomDateHandler() structured content type = %T, want randomDateResponse", result.StructuredContent)
+				}
+				if structured.Value != textContent.Text {
+					t.Fatalf("randomDateHandler() structured value != text value")
+				}
+				if structured.UnixSeconds != value.Unix() {
+					t.Fatalf("randomDateHandler() structured unix seconds = %d, want %d", structured.UnixSeconds, value.Unix())
+				}
+			}
+		})
+	}
+}
+
+func TestAddMonthsClampsToMonthEnd(t *testing.T) {
+	start := time.Date(2023, time.January, 31, 9, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		months int
+		want   time.Time
+	}{
+		{months: 1, want: time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC)},
+		{months: 2, want: time.Date(2023, time.March, 31, 9, 30, 0, 0, time.UTC)},
+		{months: 3, want: time.Date(2023, time.April, 30, 9, 30, 0, 0, time.UTC)},
+		{months: 13, want: time.Date(2024, time.February, 29, 9, 30, 0, 0, time.UTC)},
+	}
+	for _, tc := range testCases {
+		if got := addMonths(start, tc.months); !got.Equal(tc.want) {
+			t.Fatalf("addMonths(%s, %d) = %s, want %s", start, tc.months, got, tc.want)
+		}
+	}
+}
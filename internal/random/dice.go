@@ -0,0 +1,162 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxDice caps the number of dice rolled in a single call.
+	maxDice = 1000
+	// maxDiceSides caps the number of sides on a single die.
+	maxDiceSides = 1000000
+	// maxDiceModifier caps the absolute value of a dice notation modifier.
+	maxDiceModifier = 1000000
+)
+
+// diceNotation matches notation such as "3d6", "d20", "1d20+5", or "2d10-1".
+var diceNotation = regexp.MustCompile(`^(\d*)[dD](\d+)([+-]\d+)?$`)
+
+const (
+	diceModeAdvantage    = "advantage"
+	diceModeDisadvantage = "disadvantage"
+)
+
+// randomDiceResponse lists every die rolled in Rolls. With advantage or disadvantage,
+// Rolls holds both raw rolls of the single die and Kept is the one counted in Total.
+type randomDiceResponse struct {
+	Total         int64   `json:"total"`
+	Rolls         []int64 `json:"rolls"`
+	Modifier      int64   `json:"modifier"`
+	Mode          string  `json:"mode,omitempty"`
+	Kept          int64   `json:"kept,omitempty"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomDiceArgs struct {
+	Notation     string `json:"notation"`
+	Advantage    bool   `json:"advantage,omitempty"`
+	Disadvantage bool   `json:"disadvantage,omitempty"`
+}
+
+func randomDiceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomDiceArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_dice", err), nil
+	}
+
+	count, sides, modifier, err := parseDiceNotation(args.Notation)
+	if err != nil {
+		return toolErrorResult("random_dice", err), nil
+	}
+
+	mode, err := diceMode(args.Advantage, args.Disadvantage, count)
+	if err != nil {
+		return toolErrorResult("random_dice", err), nil
+	}
+
+	if mode != "" {
+		// Advantage and disadvantage roll the single die twice and keep one result.
+		count = 2
+	}
+	rolls, err := rollDice(ctx, count, sides)
+	if err != nil {
+		return toolErrorResult("random_dice", err), nil
+	}
+
+	var kept int64
+	total := modifier
+	switch mode {
+	case diceModeAdvantage:
+		kept = max(rolls[0], rolls[1])
+		total += kept
+	case diceModeDisadvantage:
+		kept = min(rolls[0], rolls[1])
+		total += kept
+	default:
+		for _, roll := range rolls {
+			total += roll
+		}
+	}
+
+	response := randomDiceResponse{Total: total, Rolls: rolls, Modifier: modifier, Mode: mode, Kept: kept, SchemaVersion: schemaVersions["random_dice"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(total, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// parseDiceNotation parses NdS[+M|-M] notation into a dice count, number of sides, and modifier.
+// A missing count means a single die.
+func parseDiceNotation(notation string) (int, int64, int64, error) {
+	matches := diceNotation.FindStringSubmatch(strings.TrimSpace(notation))
+	if matches == nil {
+		return 0, 0, 0, fmt.Errorf("invalid dice notation %q: expected NdS, NdS+M, or NdS-M", notation)
+	}
+
+	count := 1
+	if matches[1] != "" {
+		parsed, err := strconv.Atoi(matches[1])
+		if err != nil || parsed <= 0 || parsed > maxDice {
+			return 0, 0, 0, fmt.Errorf("number of dice must be between 1 and %d", maxDice)
+		}
+		count = parsed
+	}
+
+	sides, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil || sides <= 0 || sides > maxDiceSides {
+		return 0, 0, 0, fmt.Errorf("number of sides must be between 1 and %d", maxDiceSides)
+	}
+
+	var modifier int64
+	if matches[3] != "" {
+		modifier, err = strconv.ParseInt(matches[3], 10, 64)
+		if err != nil || modifier < -maxDiceModifier || modifier > maxDiceModifier {
+			return 0, 0, 0, fmt.Errorf("modifier must be between -%d and %d", maxDiceModifier, maxDiceModifier)
+		}
+	}
+
+	return count, sides, modifier, nil
+}
+
+// diceMode validates the advantage and disadvantage flags and returns the selected mode,
+// or an empty string for a plain roll. Either flag requires a single die.
+func diceMode(advantage, disadvantage bool, count int) (string, error) {
+	if advantage && disadvantage {
+		return "", fmt.Errorf("advantage and disadvantage cannot both be set")
+	}
+	if !advantage && !disadvantage {
+		return "", nil
+	}
+	if count != 1 {
+		return "", fmt.Errorf("advantage and disadvantage require a single die, got %d", count)
+	}
+	if advantage {
+		return diceModeAdvantage, nil
+	}
+	return diceModeDisadvantage, nil
+}
+
+// rollDice returns count independent rolls of a die with the given number of sides.
+func rollDice(ctx context.Context, count int, sides int64) ([]int64, error) {
+	rolls := make([]int64, count)
+	for i := range rolls {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		roll, err := sourceFromContext(ctx).Int64(1, sides)
+		if err != nil {
+			return nil, err
+		}
+		rolls[i] = roll
+	}
+	return rolls, nil
+}
@@ -0,0 +1,93 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxDieExplosions caps how many times one die in random_dice_pool may explode. With at least
+// two sides the chance of reaching it is at most 2^-100, so it only guards against a broken
+// entropy source that keeps returning the top face.
+const maxDieExplosions = 100
+
+// randomDicePoolResponse reports how many dice met or beat Target. Rolls holds each die's
+// result; with explode set, a die's result is the sum of its initial roll and any rerolls.
+type randomDicePoolResponse struct {
+	Successes     int     `json:"successes"`
+	Rolls         []int64 `json:"rolls"`
+	Target        int64   `json:"target"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomDicePoolArgs struct {
+	Dice    int   `json:"dice"`
+	Sides   int64 `json:"sides"`
+	Target  int64 `json:"target"`
+	Explode bool  `json:"explode,omitempty"`
+}
+
+func randomDicePoolHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomDicePoolArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_dice_pool", err), nil
+	}
+
+	successes, rolls, err := randomDicePool(ctx, args.Dice, args.Sides, args.Target, args.Explode)
+	if err != nil {
+		return toolErrorResult("random_dice_pool", err), nil
+	}
+
+	response := randomDicePoolResponse{Successes: successes, Rolls: rolls, Target: args.Target, SchemaVersion: schemaVersions["random_dice_pool"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.Itoa(successes)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomDicePool rolls dice dice of the given number of sides and counts those whose result is
+// at least target. When explode is set, a die that shows its top face is rolled again and the
+// new roll added, repeating for as long as it keeps showing the top face, so results above
+// sides are possible. Exploding needs at least two sides, or every die would explode forever.
+func randomDicePool(ctx context.Context, dice int, sides, target int64, explode bool) (int, []int64, error) {
+	if dice <= 0 || dice > maxDice {
+		return 0, nil, fmt.Errorf("dice must be between 1 and %d", maxDice)
+	}
+	if sides <= 0 || sides > maxDiceSides {
+		return 0, nil, fmt.Errorf("sides must be between 1 and %d", maxDiceSides)
+	}
+	if target <= 0 {
+		return 0, nil, fmt.Errorf("target must be greater than zero")
+	}
+	if explode && sides < 2 {
+		return 0, nil, fmt.Errorf("exploding dice need at least 2 sides")
+	}
+
+	rolls, err := rollDice(ctx, dice, sides)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	successes := 0
+	for i, roll := range rolls {
+		for last, explosions := roll, 0; explode && last == sides; explosions++ {
+			if explosions == maxDieExplosions {
+				return 0, nil, fmt.Errorf("%w: die exploded more than %d times", errInternal, maxDieExplosions)
+			}
+			last, err = sourceFromContext(ctx).Int64(1, sides)
+			if err != nil {
+				return 0, nil, err
+			}
+			rolls[i] += last
+		}
+		if rolls[i] >= target {
+			successes++
+		}
+	}
+	return successes, rolls, nil
+}
@@ -0,0 +1,137 @@
+package random
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomDicePoolHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		dice    int
+		sides   int64
+		target  int64
+		explode bool
+		wantErr bool
+	}{
+		{
+			desc:    "valid request",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": 10, "sides": 10, "target": 8}}},
+			dice:    10,
+			sides:   10,
+			target:  8,
+		},
+		{
+			desc:    "valid request with unreachable target",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": 5, "sides": 6, "target": 7}}},
+			dice:    5,
+			sides:   6,
+			target:  7,
+		},
+		{
+			desc:    "valid request with exploding dice",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": 200, "sides": 2, "target": 3, "explode": true}}},
+			dice:    200,
+			sides:   2,
+			target:  3,
+			explode: true,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with too many dice",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": maxDice + 1, "sides": 6, "target": 4}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero sides",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": 3, "sides": 0, "target": 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero target",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": 3, "sides": 6, "target": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request exploding one-sided dice",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"dice": 3, "sides": 1, "target": 1, "explode": true}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomDicePoolHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomDicePoolHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomDicePoolHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomDicePoolHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomDicePoolHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomDicePoolResponse)
+			if !ok {
+				t.Fatalf("randomDicePoolHandler() structured content type = %T, want randomDicePoolResponse", result.StructuredContent)
+			}
+			if len(structured.Rolls) != tc.dice || structured.Target != tc.target {
+				t.Fatalf("randomDicePoolHandler() rolled %d dice against %d, want %d against %d", len(structured.Rolls), structured.Target, tc.dice, tc.target)
+			}
+			successes := 0
+			exploded := false
+			for _, roll := range structured.Rolls {
+				if roll < 1 || (!tc.explode && roll > tc.sides) {
+					t.Fatalf("randomDicePoolHandler() roll %d out of range [1, %d]", roll, tc.sides)
+				}
+				// An exploded die always rolls again, so its result is never a whole
+				// multiple of the top face.
+				if tc.explode && roll%tc.sides == 0 {
+					t.Fatalf("randomDicePoolHandler() exploding roll %d stopped on the top face", roll)
+				}
+				if roll > tc.sides {
+					exploded = true
+				}
+				if roll >= tc.target {
+					successes++
+				}
+			}
+			if tc.explode && !exploded {
+				t.Fatalf("randomDicePoolHandler() no die exploded in %d rolls", tc.dice)
+			}
+			if structured.Successes != successes {
+				t.Fatalf("randomDicePoolHandler() successes = %d, want %d", structured.Successes, successes)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != strconv.Itoa(successes) {
+				t.Fatalf("randomDicePoolHandler() text content = %+v, want %d", result.Content[0], successes)
+			}
+		})
+	}
+}
+
+func TestRandomDicePoolCapsExplosions(t *testing.T) {
+	// Every byte selects the top face of a two-sided die, so the die would explode forever.
+	ctx := withSource(t.Context(), securerand.New(bytes.NewReader(bytes.Repeat([]byte{1}, 4*maxDieExplosions))))
+	if _, _, err := randomDicePool(ctx, 1, 2, 1, true); !errors.Is(err, errInternal) {
+		t.Fatalf("randomDicePool() error = %v, want errInternal from a source stuck on the top face", err)
+	}
+}
@@ -0,0 +1,151 @@
+package random
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomDiceHandler(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		notation string
+		count    int
+		sides    int64
+		modifier int64
+		wantErr  bool
+	}{
+		{desc: "three six-sided dice", notation: "3d6", count: 3, sides: 6},
+		{desc: "implicit single die", notation: "d20", count: 1, sides: 20},
+		{desc: "positive modifier", notation: "1d20+5", count: 1, sides: 20, modifier: 5},
+		{desc: "negative modifier", notation: "2d10-1", count: 2, sides: 10, modifier: -1},
+		{desc: "uppercase and whitespace", notation: " 4D4 ", count: 4, sides: 4},
+		{desc: "maximum dice", notation: "1000d2", count: maxDice, sides: 2},
+		{desc: "missing notation", notation: "", wantErr: true},
+		{desc: "malformed notation", notation: "3x6", wantErr: true},
+		{desc: "zero dice", notation: "0d6", wantErr: true},
+		{desc: "zero sides", notation: "2d0", wantErr: true},
+		{desc: "too many dice", notation: "1001d6", wantErr: true},
+		{desc: "too many sides", notation: "1d1000001", wantErr: true},
+		{desc: "modifier too large", notation: "1d6+99999999999999999999", wantErr: true},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"notation": tc.notation}}}
+			result, err := randomDiceHandler(ctx, request)
+			if err != nil {
+				t.Fatalf("randomDiceHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomDiceHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomDiceHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomDiceHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomDiceHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			totalFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+			if err != nil {
+				t.Fatalf("randomDiceHandler() invalid text content: %v", err)
+			}
+
+			structured, ok := result.StructuredContent.(randomDiceResponse)
+			if !ok {
+				t.Fatalf("randomDiceHandler() structured content type = %T, want randomDiceResponse", result.StructuredContent)
+			}
+			if len(structured.Rolls) != tc.count {
+				t.Fatalf("randomDiceHandler() rolled %d dice, want %d", len(structured.Rolls), tc.count)
+			}
+			if structured.Modifier != tc.modifier {
+				t.Fatalf("randomDiceHandler() modifier = %d, want %d", structured.Modifier, tc.modifier)
+			}
+
+			sum := structured.Modifier
+			for _, roll := range structured.Rolls {
+				if roll < 1 || roll > tc.sides {
+					t.Fatalf("randomDiceHandler() roll out of range: %d", roll)
+				}
+				sum += roll
+			}
+			if structured.Total != sum || totalFromText != sum {
+				t.Fatalf("randomDiceHandler() total = %d (text %d), want %d", structured.Total, totalFromText, sum)
+			}
+		})
+	}
+}
+
+func TestRandomDiceHandlerAdvantage(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		mode    string
+		wantErr bool
+	}{
+		{desc: "advantage", args: map[string]any{"notation": "d20", "advantage": true}, mode: diceModeAdvantage},
+		{desc: "disadvantage with modifier", args: map[string]any{"notation": "1d20+3", "disadvantage": true}, mode: diceModeDisadvantage},
+		{desc: "flags false keep plain roll", args: map[string]any{"notation": "2d6", "advantage": false, "disadvantage": false}},
+		{desc: "both flags set", args: map[string]any{"notation": "d20", "advantage": true, "disadvantage": true}, wantErr: true},
+		{desc: "advantage with several dice", args: map[string]any{"notation": "2d20", "advantage": true}, wantErr: true},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for range 50 {
+				result, err := randomDiceHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+				if err != nil {
+					t.Fatalf("randomDiceHandler() error = %v", err)
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomDiceHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomDiceHandler() returned error content: %+v", result.Content[0])
+				}
+
+				structured, ok := result.StructuredContent.(randomDiceResponse)
+				if !ok {
+					t.Fatalf("randomDiceHandler() structured content type = %T, want randomDiceResponse", result.StructuredContent)
+				}
+				if structured.Mode != tc.mode {
+					t.Fatalf("randomDiceHandler() mode = %q, want %q", structured.Mode, tc.mode)
+				}
+				if tc.mode == "" {
+					if structured.Kept != 0 {
+						t.Fatalf("randomDiceHandler() kept = %d for a plain roll, want 0", structured.Kept)
+					}
+					continue
+				}
+
+				if len(structured.Rolls) != 2 {
+					t.Fatalf("randomDiceHandler() rolls = %v, want both raw rolls", structured.Rolls)
+				}
+				want := max(structured.Rolls[0], structured.Rolls[1])
+				if tc.mode == diceModeDisadvantage {
+					want = min(structured.Rolls[0], structured.Rolls[1])
+				}
+				if structured.Kept != want {
+					t.Fatalf("randomDiceHandler() kept = %d from rolls %v, want %d", structured.Kept, structured.Rolls, want)
+				}
+				if structured.Total != structured.Kept+structured.Modifier {
+					t.Fatalf("randomDiceHandler() total = %d, want kept %d + modifier %d", structured.Total, structured.Kept, structured.Modifier)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package random
+
+import (
+	"context"
+	"fmt"
+)
+
+// Distributions that random_histogram and random_samples can draw from.
+const (
+	distributionUniform     = "uniform"
+	distributionNormal      = "normal"
+	distributionExponential = "exponential"
+)
+
+// distributionParams holds the optional parameters of every supported distribution; only
+// those of the chosen distribution are used. Uniform samples lie in [min, max) and default to
+// [0, 1); normal samples default to mean 0 and stddev 1; exponential samples default to rate 1.
+type distributionParams struct {
+	min, max     *float64
+	mean, stddev *float64
+	rate         *float64
+}
+
+// uniformBounds returns the uniform range after defaults are applied.
+func (p distributionParams) uniformBounds() (float64, float64) {
+	min, max := 0.0, 1.0
+	if p.min != nil {
+		min = *p.min
+	}
+	if p.max != nil {
+		max = *p.max
+	}
+	return min, max
+}
+
+// sampleDistribution draws count samples from the named distribution, stopping early with
+// ctx.Err() if ctx is done. The parameters are validated by the underlying samplers.
+func sampleDistribution(ctx context.Context, distribution string, params distributionParams, count int) ([]float64, error) {
+	switch distribution {
+	case distributionUniform:
+		min, max := params.uniformBounds()
+		samples := make([]float64, count)
+		for i := range samples {
+			if err := checkCancelled(ctx, i); err != nil {
+				return nil, err
+			}
+			value, err := sourceFromContext(ctx).Float64(min, max, true, false)
+			if err != nil {
+				return nil, err
+			}
+			samples[i] = value
+		}
+		return samples, nil
+	case distributionNormal:
+		mean, stddev := 0.0, 1.0
+		if params.mean != nil {
+			mean = *params.mean
+		}
+		if params.stddev != nil {
+			stddev = *params.stddev
+		}
+		return randomGaussians(ctx, mean, stddev, count)
+	case distributionExponential:
+		rate := 1.0
+		if params.rate != nil {
+			rate = *params.rate
+		}
+		samples := make([]float64, count)
+		for i := range samples {
+			if err := checkCancelled(ctx, i); err != nil {
+				return nil, err
+			}
+			value, err := randomExponential(ctx, rate)
+			if err != nil {
+				return nil, err
+			}
+			samples[i] = value
+		}
+		return samples, nil
+	default:
+		return nil, fmt.Errorf("unsupported distribution %q: must be %s, %s, or %s", distribution, distributionUniform, distributionNormal, distributionExponential)
+	}
+}
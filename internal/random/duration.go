@@ -0,0 +1,69 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomDurationResponse struct {
+	Nanos         int64  `json:"nanos"`
+	Unit          string `json:"unit"`
+	Duration      string `json:"duration"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomDurationArgs struct {
+	Min string `json:"min"`
+	Max string `json:"max"`
+}
+
+func randomDurationHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomDurationArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_duration", err), nil
+	}
+
+	min, err := time.ParseDuration(args.Min)
+	if err != nil {
+		return toolErrorResult("random_duration", fmt.Errorf("min: %w", err)), nil
+	}
+	max, err := time.ParseDuration(args.Max)
+	if err != nil {
+		return toolErrorResult("random_duration", fmt.Errorf("max: %w", err)), nil
+	}
+
+	duration, err := randomDuration(ctx, min, max)
+	if err != nil {
+		return toolErrorResult("random_duration", err), nil
+	}
+
+	response := randomDurationResponse{Nanos: duration.Nanoseconds(), Unit: unitNanoseconds, Duration: duration.String(), SchemaVersion: schemaVersions["random_duration"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: duration.String()},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomDuration returns a duration drawn uniformly, to the nanosecond, from the inclusive
+// range [min, max]. Neither bound may be negative and min must not exceed max.
+func randomDuration(ctx context.Context, min, max time.Duration) (time.Duration, error) {
+	if min < 0 || max < 0 {
+		return 0, fmt.Errorf("min and max must not be negative")
+	}
+	if min > max {
+		return 0, &RangeError{}
+	}
+
+	// Both bounds are non-negative, so the span cannot overflow int64.
+	offset, err := sourceFromContext(ctx).Int64(0, int64(max-min))
+	if err != nil {
+		return 0, err
+	}
+	return min + time.Duration(offset), nil
+}
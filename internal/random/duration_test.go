@@ -0,0 +1,98 @@
+package random
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomDurationHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		min     time.Duration
+		max     time.Duration
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with sub-second range",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": "100ms", "max": "2s"}}},
+			min:     100 * time.Millisecond,
+			max:     2 * time.Second,
+		},
+		{
+			desc:    "valid request with equal bounds",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": "1m30s", "max": "90s"}}},
+			min:     90 * time.Second,
+			max:     90 * time.Second,
+		},
+		{
+			desc:    "valid request from zero",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": "0s", "max": "1ns"}}},
+			min:     0,
+			max:     time.Nanosecond,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with malformed max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": "1s", "max": "soon"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with min greater than max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": "2s", "max": "1s"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative min",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": "-1s", "max": "1s"}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomDurationHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomDurationHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomDurationHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomDurationHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomDurationHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomDurationResponse)
+			if !ok {
+				t.Fatalf("randomDurationHandler() structured content type = %T, want randomDurationResponse", result.StructuredContent)
+			}
+			if structured.Unit != unitNanoseconds {
+				t.Fatalf("randomDurationHandler() unit = %q, want %q", structured.Unit, unitNanoseconds)
+			}
+			duration := time.Duration(structured.Nanos)
+			if duration < tc.min || duration > tc.max {
+				t.Fatalf("randomDurationHandler() duration %s out of range [%s, %s]", duration, tc.min, tc.max)
+			}
+			if structured.Duration != duration.String() {
+				t.Fatalf("randomDurationHandler() duration string = %q, want %q", structured.Duration, duration.String())
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Duration {
+				t.Fatalf("randomDurationHandler() text content = %+v, want %q", result.Content[0], structured.Duration)
+			}
+		})
+	}
+}
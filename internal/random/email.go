@@ -0,0 +1,118 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// defaultEmailDomain is reserved for documentation by RFC 2606, so generated addresses can
+// never reach a real mailbox.
+const defaultEmailDomain = "example.com"
+
+// emailSeparators join the name parts of a local part. The empty separator gives "janedoe".
+var emailSeparators = []string{".", "_", ""}
+
+// Limits from RFC 5321 and RFC 1035.
+const (
+	maxEmailLocalLength  = 64
+	maxEmailDomainLength = 253
+	maxDomainLabelLength = 63
+)
+
+// The optional digits are a number of up to emailDigitsMax, e.g. "jane.doe42".
+const emailDigitsMax = 99
+
+type randomEmailResponse struct {
+	Value         string `json:"value"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomEmailArgs struct {
+	Domain *string `json:"domain,omitempty"`
+	Digits bool    `json:"digits,omitempty"`
+}
+
+func randomEmailHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomEmailArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_email", err), nil
+	}
+
+	domain := defaultEmailDomain
+	if args.Domain != nil {
+		domain = *args.Domain
+	}
+
+	value, err := randomEmail(ctx, domain, args.Digits)
+	if err != nil {
+		return toolErrorResult("random_email", err), nil
+	}
+
+	response := randomEmailResponse{Value: value, SchemaVersion: schemaVersions["random_email"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomEmail returns an address at domain whose local part is a lowercase first name and
+// surname joined by a random separator, such as "amara.okafor" or "liam_tanaka". With digits
+// set, a number up to emailDigitsMax is appended. Local parts only ever contain letters,
+// digits, "." and "_", and never start or end with a dot or contain two in a row, so they are
+// valid RFC 5322 dot-atoms.
+func randomEmail(ctx context.Context, domain string, digits bool) (string, error) {
+	if err := validateEmailDomain(domain); err != nil {
+		return "", err
+	}
+
+	first, last, err := randomName(ctx, nameStyleFull)
+	if err != nil {
+		return "", err
+	}
+	separator, err := randomListItem(ctx, emailSeparators)
+	if err != nil {
+		return "", err
+	}
+
+	local := strings.ToLower(first) + separator + strings.ToLower(last)
+	if digits {
+		number, err := sourceFromContext(ctx).Int64(0, emailDigitsMax)
+		if err != nil {
+			return "", err
+		}
+		local += fmt.Sprint(number)
+	}
+	return local + "@" + domain, nil
+}
+
+// validateEmailDomain checks that domain is a hostname of at least two dot-separated labels,
+// each made of letters, digits, and inner hyphens.
+func validateEmailDomain(domain string) error {
+	if len(domain) > maxEmailDomainLength {
+		return fmt.Errorf("domain cannot be longer than %d characters", maxEmailDomainLength)
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("domain %q must contain at least one dot", domain)
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > maxDomainLabelLength {
+			return fmt.Errorf("domain %q has a label that is empty or longer than %d characters", domain, maxDomainLabelLength)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("domain %q has a label that starts or ends with a hyphen", domain)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("domain %q must only contain letters, digits, hyphens, and dots", domain)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package random
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// emailLocalPart matches the local parts random_email builds: letters joined by at most one
+// "." or "_", optionally followed by digits.
+var emailLocalPart = regexp.MustCompile(`^[a-z]+[._]?[a-z]+[0-9]*$`)
+
+func TestRandomEmailHandler(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		request    mcp.CallToolRequest
+		domain     string
+		wantDigits bool
+		wantErr    bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			domain:  defaultEmailDomain,
+		},
+		{
+			desc:    "valid request with custom domain",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"domain": "test.example.org"}}},
+			domain:  "test.example.org",
+		},
+		{
+			desc:       "valid request with digits",
+			request:    mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"digits": true}}},
+			domain:     defaultEmailDomain,
+			wantDigits: true,
+		},
+		{
+			desc:    "invalid request with single-label domain",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"domain": "localhost"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with empty label",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"domain": "example..com"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with hyphen-edged label",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"domain": "-example.com"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with illegal character",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"domain": "exa mple.com"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with overlong label",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"domain": strings.Repeat("a", maxDomainLabelLength+1) + ".com"}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomEmailHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomEmailHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomEmailHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomEmailHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomEmailHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomEmailResponse)
+			if !ok {
+				t.Fatalf("randomEmailHandler() structured content type = %T, want randomEmailResponse", result.StructuredContent)
+			}
+			local, domain, ok := strings.Cut(structured.Value, "@")
+			if !ok || domain != tc.domain {
+				t.Fatalf("randomEmailHandler() value = %q, want an address at %s", structured.Value, tc.domain)
+			}
+			if !emailLocalPart.MatchString(local) || len(local) > maxEmailLocalLength {
+				t.Fatalf("randomEmailHandler() local part %q is not a valid dot-atom", local)
+			}
+			if hasDigits := strings.ContainsAny(local, "0123456789"); hasDigits != tc.wantDigits {
+				t.Fatalf("randomEmailHandler() local part %q has digits = %v, want %v", local, hasDigits, tc.wantDigits)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Value {
+				t.Fatalf("randomEmailHandler() text content = %+v, want %q", result.Content[0], structured.Value)
+			}
+		})
+	}
+}
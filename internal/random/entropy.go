@@ -0,0 +1,31 @@
+package random
+
+import "math"
+
+// entropyBits returns the entropy in bits of length symbols drawn independently and
+// uniformly from an alphabet of the given size.
+func entropyBits(length, alphabetSize int) float64 {
+	return float64(length) * math.Log2(float64(alphabetSize))
+}
+
+// charsetEntropyBits returns the entropy in bits of length characters drawn uniformly by
+// position from charset. Repeated characters make some symbols more likely, so the
+// per-character entropy is the Shannon entropy of the charset's character frequencies;
+// for a charset without repeats this equals entropyBits(length, len(charset)).
+func charsetEntropyBits(length int, charset string) float64 {
+	runes := []rune(charset)
+	counts := make(map[rune]int, len(runes))
+	for _, r := range runes {
+		counts[r]++
+	}
+	if len(counts) == len(runes) {
+		return entropyBits(length, len(runes))
+	}
+
+	perChar := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(len(runes))
+		perChar -= p * math.Log2(p)
+	}
+	return float64(length) * perChar
+}
@@ -0,0 +1,106 @@
+package random
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestCharsetEntropyBits(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		length  int
+		charset string
+		want    float64
+	}{
+		{desc: "binary charset", length: 8, charset: "01", want: 8},
+		{desc: "hex charset", length: 10, charset: "0123456789abcdef", want: 40},
+		{desc: "multibyte runes count once", length: 3, charset: "αβγδ", want: 6},
+		{desc: "repeated characters lower entropy", length: 4, charset: "aaab", want: 4 * (-(0.75*math.Log2(0.75) + 0.25*math.Log2(0.25)))},
+		{desc: "single character has no entropy", length: 5, charset: "x", want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := charsetEntropyBits(tc.length, tc.charset); math.Abs(got-tc.want) > 1e-9 {
+				t.Fatalf("charsetEntropyBits(%d, %q) = %f, want %f", tc.length, tc.charset, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSecretResponsesReportEntropy(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    float64
+	}{
+		{
+			desc:    "16-char alphanumeric password",
+			handler: randomPasswordHandler,
+			args:    map[string]any{"length": 16, "requireSymbol": false},
+			want:    16 * math.Log2(62),
+		},
+		{
+			desc:    "password with every class",
+			handler: randomPasswordHandler,
+			args:    map[string]any{"length": 20},
+			want:    20 * math.Log2(90),
+		},
+		{
+			desc:    "32-byte token",
+			handler: randomBytesHandler,
+			args:    map[string]any{"length": 32},
+			want:    256,
+		},
+		{
+			desc:    "32-byte hex token",
+			handler: randomHexHandler,
+			args:    map[string]any{"bytes": 32},
+			want:    256,
+		},
+		{
+			desc:    "ascii string",
+			handler: randomASCIIHandler,
+			args:    map[string]any{"length": 10},
+			want:    10 * math.Log2(95),
+		},
+		{
+			desc:    "alphanumeric preset string",
+			handler: randomStringHandler,
+			args:    map[string]any{"length": 16, "preset": "alphanumeric"},
+			want:    16 * math.Log2(62),
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := tc.handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatalf("%s handler error = %v", tc.desc, err)
+			}
+			if result.IsError {
+				t.Fatalf("%s handler returned error content: %+v", tc.desc, result.Content[0])
+			}
+
+			data, err := json.Marshal(result.StructuredContent)
+			if err != nil {
+				t.Fatalf("unable to marshal structured content: %v", err)
+			}
+			var fields struct {
+				EntropyBits float64 `json:"entropyBits"`
+			}
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatalf("unable to unmarshal structured content: %v", err)
+			}
+			if math.Abs(fields.EntropyBits-tc.want) > 1e-9 {
+				t.Fatalf("%s entropyBits = %f, want %f", tc.desc, fields.EntropyBits, tc.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,129 @@
 package random
 
-type ZeroLengthError struct {
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// The tool handlers report the securerand error types so callers can match them with errors.As.
+type (
+	// ZeroLengthError is returned when a tool is asked for a non-positive length.
+	ZeroLengthError = securerand.ZeroLengthError
+	// RangeError is returned when min is greater than max.
+	RangeError = securerand.RangeError
+	// ExcludedBoundaryError is returned when excluding a bound leaves no value to draw from.
+	ExcludedBoundaryError = securerand.ExcludedBoundaryError
+	// NonFiniteError is returned when a bound is NaN or infinite.
+	NonFiniteError = securerand.NonFiniteError
+	// EntropyError is returned when the entropy source fails.
+	EntropyError = securerand.EntropyError
+)
+
+// errExclusivityEmptiesRange is returned by random_int when excluding a bound empties an
+// otherwise valid integer range.
+var errExclusivityEmptiesRange = errors.New("range is empty after applying exclusivity")
+
+// errInternal marks a server-side fault other than an entropy source failure, such as a
+// generator giving up after a bounded number of attempts. Wrap it with the details.
+var errInternal = errors.New("internal error")
+
+// Error codes reported in randomErrorResponse. They are stable, so clients may match on them.
+const (
+	errorCodeRangeInvalid     = "RANGE_INVALID"
+	errorCodeRangeEmpty       = "RANGE_EMPTY"
+	errorCodeZeroLength       = "ZERO_LENGTH"
+	errorCodeNonFinite        = "NON_FINITE"
+	errorCodeCancelled        = "CANCELLED"
+	errorCodeDeadlineExceeded = "DEADLINE_EXCEEDED"
+	errorCodeServerBusy       = "SERVER_BUSY"
+	errorCodeEntropyFailed    = "ENTROPY_FAILED"
+	errorCodeInternal         = "INTERNAL"
+	errorCodeToolFailed       = "TOOL_FAILED"
+)
+
+// Error categories reported in randomErrorResponse. They say whose fault a failure is, in the
+// terms of JSON-RPC's invalid-params and internal-error classes, so clients know whether to fix
+// the arguments or retry.
+const (
+	// errorCategoryInvalidParams marks a call the client must change before retrying.
+	errorCategoryInvalidParams = "invalid_params"
+	// errorCategoryInternal marks a server-side failure, such as an entropy source error.
+	errorCategoryInternal = "internal"
+	// errorCategoryUnavailable marks a call that was cut short, and may succeed if retried.
+	errorCategoryUnavailable = "unavailable"
+)
+
+// randomErrorResponse is the structured content of a failed tool call. Message repeats the
+// text content; Code classifies the failure for programmatic handling and Category says
+// whether the client or the server is at fault.
+type randomErrorResponse struct {
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	Message  string `json:"message"`
+}
+
+// errorCode maps err to its error code. Errors without a more specific code, such as
+// malformed arguments, map to errorCodeToolFailed.
+func errorCode(err error) string {
+	var (
+		rangeErr      *RangeError
+		boundaryErr   *ExcludedBoundaryError
+		zeroLengthErr *ZeroLengthError
+		nonFiniteErr  *NonFiniteError
+		entropyErr    *EntropyError
+	)
+	switch {
+	case errors.As(err, &rangeErr):
+		return errorCodeRangeInvalid
+	case errors.As(err, &boundaryErr), errors.Is(err, errExclusivityEmptiesRange):
+		return errorCodeRangeEmpty
+	case errors.As(err, &zeroLengthErr):
+		return errorCodeZeroLength
+	case errors.As(err, &nonFiniteErr):
+		return errorCodeNonFinite
+	case errors.Is(err, context.Canceled):
+		return errorCodeCancelled
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorCodeDeadlineExceeded
+	case errors.Is(err, errServerBusy):
+		return errorCodeServerBusy
+	case errors.As(err, &entropyErr):
+		return errorCodeEntropyFailed
+	case errors.Is(err, errInternal):
+		return errorCodeInternal
+	default:
+		return errorCodeToolFailed
+	}
+}
+
+// errorCategory maps err to its error category. Entropy source failures and errInternal are
+// internal and cancellation, deadlines, and a busy server are transient. Everything else is a
+// validation failure.
+func errorCategory(err error) string {
+	var entropyErr *EntropyError
+	switch {
+	case errors.As(err, &entropyErr), errors.Is(err, errInternal):
+		return errorCategoryInternal
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded), errors.Is(err, errServerBusy):
+		return errorCategoryUnavailable
+	default:
+		return errorCategoryInvalidParams
+	}
 }
 
-func (e *ZeroLengthError) Error() string {
-	return "length cannot be zero"
+// toolErrorResult returns the failed result of the named tool for err: a "<tool> failed: <err>"
+// text message, mirrored in a randomErrorResponse together with the error's code and category.
+func toolErrorResult(tool string, err error) *mcp.CallToolResult {
+	message := fmt.Sprintf("%s failed: %v", tool, err)
+	return &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: message},
+		},
+		StructuredContent: randomErrorResponse{Code: errorCode(err), Category: errorCategory(err), Message: message},
+	}
 }
@@ -0,0 +1,165 @@
+package random
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestErrorCode(t *testing.T) {
+	testCases := []struct {
+		desc string
+		err  error
+		want string
+	}{
+		{desc: "range error", err: &RangeError{}, want: errorCodeRangeInvalid},
+		{desc: "wrapped range error", err: fmt.Errorf("min: %w", &RangeError{}), want: errorCodeRangeInvalid},
+		{desc: "excluded boundary error", err: &ExcludedBoundaryError{Min: 1, Max: 1}, want: errorCodeRangeEmpty},
+		{desc: "integer range emptied by exclusivity", err: errExclusivityEmptiesRange, want: errorCodeRangeEmpty},
+		{desc: "zero length error", err: &ZeroLengthError{}, want: errorCodeZeroLength},
+		{desc: "non-finite error", err: &NonFiniteError{}, want: errorCodeNonFinite},
+		{desc: "cancelled", err: context.Canceled, want: errorCodeCancelled},
+		{desc: "deadline exceeded", err: context.DeadlineExceeded, want: errorCodeDeadlineExceeded},
+		{desc: "server busy", err: errServerBusy, want: errorCodeServerBusy},
+		{desc: "entropy error", err: &EntropyError{Err: io.ErrUnexpectedEOF}, want: errorCodeEntropyFailed},
+		{desc: "wrapped internal error", err: fmt.Errorf("%w: die exploded", errInternal), want: errorCodeInternal},
+		{desc: "untyped error", err: errors.New("count must be between 1 and 10"), want: errorCodeToolFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := errorCode(tc.err); got != tc.want {
+				t.Fatalf("errorCode(%v) = %q, want %q", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestErrorCategory(t *testing.T) {
+	testCases := []struct {
+		desc string
+		err  error
+		want string
+	}{
+		{desc: "range error", err: &RangeError{}, want: errorCategoryInvalidParams},
+		{desc: "excluded boundary error", err: &ExcludedBoundaryError{Min: 1, Max: 1}, want: errorCategoryInvalidParams},
+		{desc: "zero length error", err: &ZeroLengthError{}, want: errorCategoryInvalidParams},
+		{desc: "non-finite error", err: &NonFiniteError{}, want: errorCategoryInvalidParams},
+		{desc: "untyped validation error", err: errors.New("count must be between 1 and 10"), want: errorCategoryInvalidParams},
+		{desc: "entropy error", err: &EntropyError{Err: io.ErrUnexpectedEOF}, want: errorCategoryInternal},
+		{desc: "wrapped entropy error", err: fmt.Errorf("min: %w", &EntropyError{Err: io.EOF}), want: errorCategoryInternal},
+		{desc: "wrapped internal error", err: fmt.Errorf("%w: die exploded", errInternal), want: errorCategoryInternal},
+		{desc: "cancelled", err: context.Canceled, want: errorCategoryUnavailable},
+		{desc: "deadline exceeded", err: context.DeadlineExceeded, want: errorCategoryUnavailable},
+		{desc: "server busy", err: errServerBusy, want: errorCategoryUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := errorCategory(tc.err); got != tc.want {
+				t.Fatalf("errorCategory(%v) = %q, want %q", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHandlersReportErrorCodes(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		handler      func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args         map[string]any
+		source       *securerand.Rand
+		wantCode     string
+		wantCategory string
+	}{
+		{
+			desc:         "random_int with min greater than max",
+			handler:      randomIntHandler,
+			args:         map[string]any{"min": int64(10), "max": int64(1)},
+			wantCode:     errorCodeRangeInvalid,
+			wantCategory: errorCategoryInvalidParams,
+		},
+		{
+			desc:         "random_int emptied by exclusivity",
+			handler:      randomIntHandler,
+			args:         map[string]any{"min": int64(5), "max": int64(5), "includeMin": false},
+			wantCode:     errorCodeRangeEmpty,
+			wantCategory: errorCategoryInvalidParams,
+		},
+		{
+			desc:         "random_ascii with zero length",
+			handler:      randomASCIIHandler,
+			args:         map[string]any{"length": 0},
+			wantCode:     errorCodeZeroLength,
+			wantCategory: errorCategoryInvalidParams,
+		},
+		{
+			desc:         "random_int with invalid count",
+			handler:      randomIntHandler,
+			args:         map[string]any{"count": 0},
+			wantCode:     errorCodeToolFailed,
+			wantCategory: errorCategoryInvalidParams,
+		},
+		{
+			desc:         "random_int with a failing entropy source",
+			handler:      randomIntHandler,
+			args:         map[string]any{"min": int64(1), "max": int64(6)},
+			source:       securerand.New(bytes.NewReader(nil)),
+			wantCode:     errorCodeEntropyFailed,
+			wantCategory: errorCategoryInternal,
+		},
+		{
+			desc:         "random_dice_pool with a die that keeps exploding",
+			handler:      randomDicePoolHandler,
+			args:         map[string]any{"dice": 1, "sides": 2, "target": 1, "explode": true},
+			source:       securerand.New(bytes.NewReader(bytes.Repeat([]byte{1}, 4*maxDieExplosions))),
+			wantCode:     errorCodeInternal,
+			wantCategory: errorCategoryInternal,
+		},
+		{
+			desc:         "random_gaussian with a failing entropy source",
+			handler:      randomGaussianHandler,
+			args:         map[string]any{},
+			source:       securerand.New(bytes.NewReader([]byte{1, 2, 3})),
+			wantCode:     errorCodeEntropyFailed,
+			wantCategory: errorCategoryInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.source != nil {
+				ctx = withSource(ctx, tc.source)
+			}
+			result, err := tc.handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatalf("%s handler error = %v", tc.desc, err)
+			}
+			if !result.IsError {
+				t.Fatalf("%s handler expected error, got success", tc.desc)
+			}
+
+			structured, ok := result.StructuredContent.(randomErrorResponse)
+			if !ok {
+				t.Fatalf("%s structured content type = %T, want randomErrorResponse", tc.desc, result.StructuredContent)
+			}
+			if structured.Code != tc.wantCode {
+				t.Fatalf("%s error code = %q, want %q", tc.desc, structured.Code, tc.wantCode)
+			}
+			if structured.Category != tc.wantCategory {
+				t.Fatalf("%s error category = %q, want %q", tc.desc, structured.Category, tc.wantCategory)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Message {
+				t.Fatalf("%s text content = %+v, want message %q", tc.desc, result.Content[0], structured.Message)
+			}
+		})
+	}
+}
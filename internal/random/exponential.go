@@ -0,0 +1,68 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomExponentialResponse struct {
+	Value         float64 `json:"value"`
+	Rate          float64 `json:"rate"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomExponentialArgs struct {
+	Rate *float64 `json:"rate,omitempty"`
+}
+
+func randomExponentialHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomExponentialArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_exponential", err), nil
+	}
+
+	rate := 1.0
+	if args.Rate != nil {
+		rate = *args.Rate
+	}
+
+	value, err := randomExponential(ctx, rate)
+	if err != nil {
+		return toolErrorResult("random_exponential", err), nil
+	}
+
+	response := randomExponentialResponse{Value: value, Rate: rate, SchemaVersion: schemaVersions["random_exponential"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%g", value)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomExponential returns a sample from the exponential distribution with the given rate (lambda)
+// using the inverse-CDF method. Rate must be finite and greater than zero.
+func randomExponential(ctx context.Context, rate float64) (float64, error) {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return 0, fmt.Errorf("rate must be finite")
+	}
+	if rate <= 0 {
+		return 0, fmt.Errorf("rate must be greater than zero")
+	}
+	// -ln(1-unit) is at most 53*ln(2), so this bounds every sample; tiny rates would overflow to +Inf.
+	if math.IsInf(53*math.Ln2/rate, 0) {
+		return 0, fmt.Errorf("rate is too small: samples could overflow")
+	}
+
+	unit, err := sourceFromContext(ctx).UnitFloat64()
+	if err != nil {
+		return 0, err
+	}
+
+	// unit lies in [0, 1), so 1-unit lies in (0, 1] and the logarithm never reaches -Inf.
+	return -math.Log(1-unit) / rate, nil
+}
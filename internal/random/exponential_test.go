@@ -0,0 +1,98 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomExponentialHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		rate    float64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			rate:    1,
+		},
+		{
+			desc:    "valid request with rate",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"rate": 0.25}}},
+			rate:    0.25,
+		},
+		{
+			desc:    "invalid request with zero rate",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"rate": 0.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with rate small enough to overflow",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"rate": 5e-324}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative rate",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"rate": -2.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomExponentialHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomExponentialHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomExponentialHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomExponentialHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomExponentialHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomExponentialHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			valueFromText, err := strconv.ParseFloat(textContent.Text, 64)
+			if err != nil {
+				t.Fatalf("randomExponentialHandler() invalid text content: %v", err)
+			}
+			if valueFromText < 0 || math.IsInf(valueFromText, 0) {
+				t.Fatalf("randomExponentialHandler() value out of range: %f", valueFromText)
+			}
+
+			structured, ok := result.StructuredContent.(randomExponentialResponse)
+			if !ok {
+				t.Fatalf("randomExponentialHandler() structured content type = %T, want randomExponentialResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomExponentialHandler() structured value %f != text value %f", structured.Value, valueFromText)
+			}
+			if structured.Rate != tc.rate {
+				t.Fatalf("randomExponentialHandler() structured rate = %f, want %f", structured.Rate, tc.rate)
+			}
+		})
+	}
+}
+
+func TestRandomExponentialRejectsNonFinite(t *testing.T) {
+	for _, rate := range []float64{math.NaN(), math.Inf(1)} {
+		if _, err := randomExponential(t.Context(), rate); err == nil {
+			t.Fatalf("randomExponential(%v) expected error, got nil", rate)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package random
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/kevensen/go-random-number-mcp/internal/bufpool"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxGaussianCount caps the number of samples random_gaussian will generate in a single call.
+const maxGaussianCount = 100000
+
+// gaussianBatchPairs is how many Box-Muller pairs randomGaussians draws entropy for in one
+// read. Each pair takes two 8-byte uniforms, so a full batch reads 4 KiB.
+const gaussianBatchPairs = 256
+
+type randomGaussianResponse struct {
+	Value         float64   `json:"value"`
+	Values        []float64 `json:"values,omitempty"`
+	Mean          float64   `json:"mean"`
+	StdDev        float64   `json:"stddev"`
+	SchemaVersion int       `json:"schemaVersion"`
+	Algorithm     string    `json:"algorithm"`
+}
+
+type randomGaussianArgs struct {
+	Mean   *float64 `json:"mean,omitempty"`
+	StdDev *float64 `json:"stddev,omitempty"`
+	Count  *int     `json:"count,omitempty"`
+}
+
+func randomGaussianHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomGaussianArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_gaussian", err), nil
+	}
+
+	mean := 0.0
+	stddev := 1.0
+	if args.Mean != nil {
+		mean = *args.Mean
+	}
+	if args.StdDev != nil {
+		stddev = *args.StdDev
+	}
+
+	count := 1
+	if args.Count != nil {
+		count = *args.Count
+	}
+	if count <= 0 || count > maxGaussianCount {
+		return toolErrorResult("random_gaussian", fmt.Errorf("count must be between 1 and %d", maxGaussianCount)), nil
+	}
+
+	values, err := randomGaussians(ctx, mean, stddev, count)
+	if err != nil {
+		return toolErrorResult("random_gaussian", err), nil
+	}
+
+	if count == 1 {
+		value := values[0]
+		response := randomGaussianResponse{Value: value, Mean: mean, StdDev: stddev, SchemaVersion: schemaVersions["random_gaussian"], Algorithm: algorithmCryptoRand}
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.TextContent{Type: "text", Text: fmt.Sprintf("%g", value)},
+			},
+			StructuredContent: response,
+		}, nil
+	}
+
+	lines := make([]string, count)
+	for i, value := range values {
+		lines[i] = strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	response := randomGaussianResponse{Value: values[0], Values: values, Mean: mean, StdDev: stddev, SchemaVersion: schemaVersions["random_gaussian"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomGaussian returns a sample from the normal distribution with the given mean and standard deviation.
+// Mean must be finite and stddev must be finite and greater than zero.
+func randomGaussian(ctx context.Context, mean, stddev float64) (float64, error) {
+	if err := validateGaussianParams(mean, stddev); err != nil {
+		return 0, err
+	}
+
+	z, _, err := standardNormalPair(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return mean + stddev*z, nil
+}
+
+// randomGaussians returns count independent samples from the normal distribution with the
+// given mean and standard deviation. Both outputs of each Box-Muller transform are used, so
+// count samples cost ceil(count/2) transforms. Rather than drawing each uniform separately,
+// entropy is read gaussianBatchPairs pairs at a time and converted in place, which avoids a
+// big.Int allocation and an entropy source call per uniform.
+func randomGaussians(ctx context.Context, mean, stddev float64, count int) ([]float64, error) {
+	if err := validateGaussianParams(mean, stddev); err != nil {
+		return nil, err
+	}
+
+	src := sourceFromContext(ctx)
+	values := make([]float64, count)
+	buf := bufpool.Get(16 * min((count+1)/2, gaussianBatchPairs))
+	defer bufpool.Put(buf)
+	for i := 0; i < count; {
+		// Each batch covers hundreds of samples, so check for cancellation before every one.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		chunk := (*buf)[:16*min((count-i+1)/2, gaussianBatchPairs)]
+		if _, err := src.Read(chunk); err != nil {
+			return nil, err
+		}
+		for ; len(chunk) > 0; chunk = chunk[16:] {
+			z0, z1 := boxMuller(unitFloatFromBytes(chunk[:8]), unitFloatFromBytes(chunk[8:16]))
+			values[i] = mean + stddev*z0
+			i++
+			if i < count {
+				values[i] = mean + stddev*z1
+				i++
+			}
+		}
+	}
+	return values, nil
+}
+
+// maxBoxMullerRadius bounds the magnitude of every sample boxMuller returns: 1-u1 is at least
+// 2^-53, so the radius is at most sqrt(-2*ln(2^-53)), about 8.57.
+var maxBoxMullerRadius = math.Sqrt(106 * math.Ln2)
+
+func validateGaussianParams(mean, stddev float64) error {
+	if math.IsNaN(mean) || math.IsInf(mean, 0) || math.IsNaN(stddev) || math.IsInf(stddev, 0) {
+		return fmt.Errorf("mean and stddev must be finite")
+	}
+	if stddev <= 0 {
+		return fmt.Errorf("stddev must be greater than zero")
+	}
+	// Reject parameters for which mean + stddev*z could overflow to ±Inf.
+	if math.IsInf(math.Abs(mean)+maxBoxMullerRadius*stddev, 0) {
+		return fmt.Errorf("mean and stddev are too large: samples could overflow")
+	}
+	return nil
+}
+
+// standardNormalPair returns two independent standard normal samples using the Box-Muller transform.
+func standardNormalPair(ctx context.Context) (float64, float64, error) {
+	u1, err := sourceFromContext(ctx).UnitFloat64()
+	if err != nil {
+		return 0, 0, err
+	}
+	u2, err := sourceFromContext(ctx).UnitFloat64()
+	if err != nil {
+		return 0, 0, err
+	}
+	z0, z1 := boxMuller(u1, u2)
+	return z0, z1, nil
+}
+
+// boxMuller maps two independent uniforms in [0, 1) to two independent standard normal samples.
+func boxMuller(u1, u2 float64) (float64, float64) {
+	// Both uniforms lie in [0, 1); shift u1 into (0, 1] so the logarithm stays finite.
+	radius := math.Sqrt(-2 * math.Log(1-u1))
+	theta := 2 * math.Pi * u2
+	return radius * math.Cos(theta), radius * math.Sin(theta)
+}
+
+// unitFloatFromBytes converts 8 random bytes to a float on the same grid as
+// securerand.UnitFloat64: the top 53 bits give k, and the result is k/2^53 in [0, 1).
+func unitFloatFromBytes(b []byte) float64 {
+	return float64(binary.BigEndian.Uint64(b)>>11) / (1 << 53)
+}
@@ -0,0 +1,269 @@
+package random
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomGaussianHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		mean    float64
+		stddev  float64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			mean:    0,
+			stddev:  1,
+		},
+		{
+			desc:    "valid request with mean and stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 100.0, "stddev": 15.0}}},
+			mean:    100,
+			stddev:  15,
+		},
+		{
+			desc:    "invalid request with zero stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"stddev": 0.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with count of one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 1}}},
+			mean:    0,
+			stddev:  1,
+		},
+		{
+			desc:    "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with count over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": maxGaussianCount + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with mean and stddev large enough to overflow",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 1e308, "stddev": 1e308}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"stddev": -1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomGaussianHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomGaussianHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomGaussianHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomGaussianHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomGaussianHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomGaussianHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			valueFromText, err := strconv.ParseFloat(textContent.Text, 64)
+			if err != nil {
+				t.Fatalf("randomGaussianHandler() invalid text content: %v", err)
+			}
+			if math.IsNaN(valueFromText) || math.IsInf(valueFromText, 0) {
+				t.Fatalf("randomGaussianHandler() value is not finite: %f", valueFromText)
+			}
+
+			structured, ok := result.StructuredContent.(randomGaussianResponse)
+			if !ok {
+				t.Fatalf("randomGaussianHandler() structured content type = %T, want randomGaussianResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomGaussianHandler() structured value %f != text value %f", structured.Value, valueFromText)
+			}
+			if structured.Mean != tc.mean || structured.StdDev != tc.stddev {
+				t.Fatalf("randomGaussianHandler() structured mean/stddev = %f/%f, want %f/%f", structured.Mean, structured.StdDev, tc.mean, tc.stddev)
+			}
+		})
+	}
+}
+
+func TestRandomGaussianRejectsNonFinite(t *testing.T) {
+	testCases := []struct {
+		mean   float64
+		stddev float64
+	}{
+		{mean: math.NaN(), stddev: 1},
+		{mean: math.Inf(1), stddev: 1},
+		{mean: 0, stddev: math.Inf(1)},
+		{mean: 0, stddev: math.NaN()},
+	}
+	for _, tc := range testCases {
+		if _, err := randomGaussian(t.Context(), tc.mean, tc.stddev); err == nil {
+			t.Fatalf("randomGaussian(%v, %v) expected error, got nil", tc.mean, tc.stddev)
+		}
+	}
+}
+
+func TestRandomGaussianHandlerCount(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		count int
+	}{
+		{desc: "even count", count: 4},
+		{desc: "odd count", count: 7},
+		{desc: "maximum count", count: maxGaussianCount},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 10.0, "stddev": 2.0, "count": tc.count}}}
+			result, err := randomGaussianHandler(ctx, request)
+			if err != nil {
+				t.Fatalf("randomGaussianHandler() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("randomGaussianHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomGaussianResponse)
+			if !ok {
+				t.Fatalf("randomGaussianHandler() structured content type = %T, want randomGaussianResponse", result.StructuredContent)
+			}
+			if len(structured.Values) != tc.count {
+				t.Fatalf("randomGaussianHandler() returned %d values, want %d", len(structured.Values), tc.count)
+			}
+			if structured.Value != structured.Values[0] {
+				t.Fatalf("randomGaussianHandler() value = %g, want first of values %g", structured.Value, structured.Values[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomGaussianHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			lines := strings.Split(textContent.Text, "\n")
+			if len(lines) != tc.count {
+				t.Fatalf("randomGaussianHandler() text has %d lines, want %d", len(lines), tc.count)
+			}
+			for i, line := range lines {
+				value, err := strconv.ParseFloat(line, 64)
+				if err != nil {
+					t.Fatalf("randomGaussianHandler() invalid text line %q: %v", line, err)
+				}
+				if value != structured.Values[i] {
+					t.Fatalf("randomGaussianHandler() text line %d = %g, want %g", i, value, structured.Values[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRandomGaussiansMoments(t *testing.T) {
+	const (
+		mean   = 5.0
+		stddev = 3.0
+		count  = 100000
+	)
+	values, err := randomGaussians(t.Context(), mean, stddev, count)
+	if err != nil {
+		t.Fatalf("randomGaussians() error = %v", err)
+	}
+
+	var sum, sumSquares float64
+	for _, value := range values {
+		sum += value
+		sumSquares += value * value
+	}
+	gotMean := sum / count
+	gotStdDev := math.Sqrt(sumSquares/count - gotMean*gotMean)
+
+	// The standard error of the sample mean is stddev/sqrt(count) ~= 0.0095; allow five of them.
+	if math.Abs(gotMean-mean) > 0.05 {
+		t.Fatalf("randomGaussians() sample mean = %f, want %f", gotMean, mean)
+	}
+	if math.Abs(gotStdDev-stddev) > 0.05 {
+		t.Fatalf("randomGaussians() sample stddev = %f, want %f", gotStdDev, stddev)
+	}
+}
+
+func TestUnitFloatFromBytes(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		bytes []byte
+		want  float64
+	}{
+		{desc: "all zero bits", bytes: []byte{0, 0, 0, 0, 0, 0, 0, 0}, want: 0},
+		{desc: "top bit only", bytes: []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, want: 0.5},
+		{desc: "low bits are dropped", bytes: []byte{0, 0, 0, 0, 0, 0, 0x07, 0xff}, want: 0},
+		{desc: "all one bits", bytes: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, want: 1 - 1.0/(1<<53)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := unitFloatFromBytes(tc.bytes); got != tc.want {
+				t.Fatalf("unitFloatFromBytes(%x) = %v, want %v", tc.bytes, got, tc.want)
+			}
+		})
+	}
+}
+
+// unbufferedGaussians is the previous randomGaussians, which drew each uniform with its own
+// securerand.UnitFloat64 call. It is kept to benchmark the batched implementation against.
+func unbufferedGaussians(ctx context.Context, mean, stddev float64, count int) ([]float64, error) {
+	values := make([]float64, 0, count)
+	for len(values) < count {
+		z0, z1, err := standardNormalPair(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, mean+stddev*z0)
+		if len(values) < count {
+			values = append(values, mean+stddev*z1)
+		}
+	}
+	return values, nil
+}
+
+func BenchmarkGaussians(b *testing.B) {
+	implementations := []struct {
+		name     string
+		generate func(context.Context, float64, float64, int) ([]float64, error)
+	}{
+		{name: "unbuffered", generate: unbufferedGaussians},
+		{name: "batched", generate: randomGaussians},
+	}
+
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := impl.generate(b.Context(), 0, 1, maxGaussianCount); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
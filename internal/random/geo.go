@@ -0,0 +1,130 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomGeoResponse struct {
+	Lat           float64 `json:"lat"`
+	Lon           float64 `json:"lon"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+// randomGeoArgs bounds the generated point to a box. Omitted bounds default to the whole
+// globe; boxes that cross the antimeridian are not supported.
+type randomGeoArgs struct {
+	MinLat *float64 `json:"minLat,omitempty"`
+	MaxLat *float64 `json:"maxLat,omitempty"`
+	MinLon *float64 `json:"minLon,omitempty"`
+	MaxLon *float64 `json:"maxLon,omitempty"`
+}
+
+// geoBox is a latitude/longitude bounding box in degrees.
+type geoBox struct {
+	minLat, maxLat float64
+	minLon, maxLon float64
+}
+
+func randomGeoHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomGeoArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_geo", err), nil
+	}
+
+	box := geoBox{minLat: -90, maxLat: 90, minLon: -180, maxLon: 180}
+	if args.MinLat != nil {
+		box.minLat = *args.MinLat
+	}
+	if args.MaxLat != nil {
+		box.maxLat = *args.MaxLat
+	}
+	if args.MinLon != nil {
+		box.minLon = *args.MinLon
+	}
+	if args.MaxLon != nil {
+		box.maxLon = *args.MaxLon
+	}
+
+	lat, lon, err := randomGeo(ctx, box)
+	if err != nil {
+		return toolErrorResult("random_geo", err), nil
+	}
+
+	response := randomGeoResponse{Lat: lat, Lon: lon, SchemaVersion: schemaVersions["random_geo"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%g,%g", lat, lon)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomGeo returns a point distributed uniformly over the part of the sphere's surface inside
+// box. Longitude is uniform in [minLon, maxLon). Latitude is not: a band's area is proportional
+// to the change in sin(latitude), so drawing sin(latitude) uniformly and taking the arcsine
+// avoids clustering points at the poles. For the whole globe this is asin(2u-1).
+func randomGeo(ctx context.Context, box geoBox) (float64, float64, error) {
+	if err := box.validate(); err != nil {
+		return 0, 0, err
+	}
+
+	src := sourceFromContext(ctx)
+	u, err := src.UnitFloat64()
+	if err != nil {
+		return 0, 0, err
+	}
+	v, err := src.UnitFloat64()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lo := math.Sin(degreesToRadians(box.minLat))
+	hi := math.Sin(degreesToRadians(box.maxLat))
+	lat := radiansToDegrees(math.Asin(lo + u*(hi-lo)))
+	lon := box.minLon + v*(box.maxLon-box.minLon)
+
+	// Rounding in the sine round trip can step just outside the box.
+	lat = math.Min(math.Max(lat, box.minLat), box.maxLat)
+	return lat, lon, nil
+}
+
+func (b geoBox) validate() error {
+	if err := requireFinite("minLat", b.minLat); err != nil {
+		return err
+	}
+	if err := requireFinite("maxLat", b.maxLat); err != nil {
+		return err
+	}
+	if err := requireFinite("minLon", b.minLon); err != nil {
+		return err
+	}
+	if err := requireFinite("maxLon", b.maxLon); err != nil {
+		return err
+	}
+	if b.minLat < -90 || b.maxLat > 90 {
+		return fmt.Errorf("latitude bounds must lie within [-90, 90]")
+	}
+	if b.minLon < -180 || b.maxLon > 180 {
+		return fmt.Errorf("longitude bounds must lie within [-180, 180]")
+	}
+	if b.minLat >= b.maxLat {
+		return fmt.Errorf("minLat must be less than maxLat")
+	}
+	if b.minLon >= b.maxLon {
+		return fmt.Errorf("minLon must be less than maxLon")
+	}
+	return nil
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
@@ -0,0 +1,122 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomGeoHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		box     geoBox
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			box:     geoBox{minLat: -90, maxLat: 90, minLon: -180, maxLon: 180},
+		},
+		{
+			desc:    "valid request with bounding box",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"minLat": 40.0, "maxLat": 41.0, "minLon": -74.5, "maxLon": -73.5}}},
+			box:     geoBox{minLat: 40, maxLat: 41, minLon: -74.5, maxLon: -73.5},
+		},
+		{
+			desc:    "valid request with partial box",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"minLat": 60.0}}},
+			box:     geoBox{minLat: 60, maxLat: 90, minLon: -180, maxLon: 180},
+		},
+		{
+			desc:    "invalid request with inverted latitude",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"minLat": 10.0, "maxLat": -10.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request crossing the antimeridian",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"minLon": 170.0, "maxLon": -170.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with latitude out of range",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"maxLat": 91.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with longitude out of range",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"minLon": -181.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomGeoHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomGeoHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomGeoHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomGeoHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomGeoHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomGeoResponse)
+			if !ok {
+				t.Fatalf("randomGeoHandler() structured content type = %T, want randomGeoResponse", result.StructuredContent)
+			}
+			if structured.Lat < tc.box.minLat || structured.Lat > tc.box.maxLat {
+				t.Fatalf("randomGeoHandler() lat %g out of range [%g, %g]", structured.Lat, tc.box.minLat, tc.box.maxLat)
+			}
+			if structured.Lon < tc.box.minLon || structured.Lon >= tc.box.maxLon {
+				t.Fatalf("randomGeoHandler() lon %g out of range [%g, %g)", structured.Lon, tc.box.minLon, tc.box.maxLon)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != fmt.Sprintf("%g,%g", structured.Lat, structured.Lon) {
+				t.Fatalf("randomGeoHandler() text content = %+v, want lat,lon", result.Content[0])
+			}
+		})
+	}
+}
+
+func TestGeoBoxNamesNonFiniteBound(t *testing.T) {
+	err := geoBox{minLat: -10, maxLat: 10, minLon: math.NaN(), maxLon: 20}.validate()
+	if err == nil || !strings.Contains(err.Error(), "minLon") {
+		t.Fatalf("validate() error = %v, want one naming minLon", err)
+	}
+}
+
+func TestRandomGeoIsUniformOverSphere(t *testing.T) {
+	// A band's share of the sphere's area is the change in sin(latitude) / 2, so the
+	// polar cap above 60 degrees holds (1 - sin 60) / 2 ~= 6.7% of uniformly spread points,
+	// far less than the 16.7% that uniform latitudes would put there.
+	const samples = 20000
+	whole := geoBox{minLat: -90, maxLat: 90, minLon: -180, maxLon: 180}
+	polar := 0
+	for range samples {
+		lat, _, err := randomGeo(t.Context(), whole)
+		if err != nil {
+			t.Fatalf("randomGeo() error = %v", err)
+		}
+		if lat > 60 {
+			polar++
+		}
+	}
+
+	want := (1 - math.Sin(degreesToRadians(60))) / 2
+	if got := float64(polar) / samples; math.Abs(got-want) > 0.01 {
+		t.Fatalf("randomGeo() share of points above 60N = %.4f, want about %.4f", got, want)
+	}
+}
@@ -0,0 +1,51 @@
+package random
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// heavyTools lists the tools whose cost grows with their arguments far enough that a burst of
+// large calls could saturate the CPU. WithMaxConcurrentHeavy bounds how many of them run at once.
+var heavyTools = map[string]bool{
+	"random_prime":       true,
+	"random_bigint":      true,
+	"random_permutation": true,
+	"random_shuffle":     true,
+	"random_histogram":   true,
+	"random_samples":     true,
+	"random_gaussian":    true,
+	"random_int_stream":  true,
+}
+
+// errServerBusy is returned when every heavy tool slot is taken.
+var errServerBusy = errors.New("server busy: too many concurrent heavy requests, retry later")
+
+// heavyMiddleware lets at most limit heavy tool calls run at once. A call that finds every
+// slot taken fails immediately with errServerBusy rather than queueing, so a burst of large
+// requests cannot pile up behind one another. Other tools pass straight through. A
+// non-positive limit disables the check.
+func heavyMiddleware(limit int) server.ToolHandlerMiddleware {
+	// mcp-go applies middleware afresh on every call, so the slots are shared from here.
+	slots := make(chan struct{}, max(0, limit))
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if limit <= 0 {
+			return next
+		}
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if !heavyTools[request.Params.Name] {
+				return next(ctx, request)
+			}
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+				return next(ctx, request)
+			default:
+				return toolErrorResult(request.Params.Name, errServerBusy), nil
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package random
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestHeavyMiddleware(t *testing.T) {
+	const limit = 2
+
+	// blockingHandler holds its slot until release is closed, so the test controls exactly how
+	// many calls are in flight.
+	started := make(chan struct{}, limit+1)
+	release := make(chan struct{})
+	blockingHandler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started <- struct{}{}
+		<-release
+		return mcp.NewToolResultText("done"), nil
+	}
+	// Like mcp-go, wrap the handler afresh for every call.
+	middleware := heavyMiddleware(limit)
+	call := func(name string) *mcp.CallToolResult {
+		result, err := middleware(blockingHandler)(t.Context(), mcp.CallToolRequest{Params: mcp.CallToolParams{Name: name}})
+		if err != nil {
+			t.Errorf("heavyMiddleware() error = %v", err)
+		}
+		return result
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*mcp.CallToolResult, limit+1)
+	for i := range limit {
+		wg.Go(func() { results[i] = call("random_prime") })
+		<-started
+	}
+	// Lightweight tools are not limited.
+	wg.Go(func() { results[limit] = call("random_int") })
+	<-started
+
+	// Every slot is taken, so another heavy call is turned away at once.
+	result := call("random_permutation")
+	if result == nil || !result.IsError {
+		t.Fatalf("heavyMiddleware() over-limit result = %+v, want error result", result)
+	}
+	structured, ok := result.StructuredContent.(randomErrorResponse)
+	if !ok || structured.Code != errorCodeServerBusy {
+		t.Fatalf("heavyMiddleware() structured content = %+v, want code %q", result.StructuredContent, errorCodeServerBusy)
+	}
+
+	close(release)
+	wg.Wait()
+	for i, result := range results {
+		if result == nil || result.IsError {
+			t.Fatalf("heavyMiddleware() in-limit result %d = %+v, want success", i, result)
+		}
+	}
+
+	// Finished calls free their slots.
+	for range limit {
+		if result := call("random_prime"); result == nil || result.IsError {
+			t.Fatalf("heavyMiddleware() result after release = %+v, want success", result)
+		}
+		<-started
+	}
+}
+
+func TestHeavyMiddlewareDisabled(t *testing.T) {
+	called := false
+	next := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		called = true
+		return mcp.NewToolResultText("done"), nil
+	}
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "random_prime"}}
+	if result, _ := heavyMiddleware(0)(next)(t.Context(), request); !called || result.IsError {
+		t.Fatalf("heavyMiddleware(0) result = %+v, want the handler to run", result)
+	}
+}
@@ -0,0 +1,57 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxHexBytes caps the number of random bytes random_hex will encode in a single call.
+const maxHexBytes = 4096
+
+type randomHexResponse struct {
+	Value         string  `json:"value"`
+	Bytes         int     `json:"bytes"`
+	EntropyBits   float64 `json:"entropyBits"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomHexArgs struct {
+	Bytes int `json:"bytes"`
+}
+
+func randomHexHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomHexArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_hex", err), nil
+	}
+
+	value, err := randomHex(ctx, args.Bytes)
+	if err != nil {
+		return toolErrorResult("random_hex", err), nil
+	}
+	slog.InfoContext(ctx, "randomHexHandler", slog.Int("bytes", args.Bytes), resultAttr("random_hex", value))
+
+	response := randomHexResponse{Value: value, Bytes: args.Bytes, EntropyBits: entropyBits(args.Bytes, 256), SchemaVersion: schemaVersions["random_hex"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomHex returns n cryptographically secure random bytes as a lowercase hex string of 2*n characters.
+// N must be greater than zero and no more than maxHexBytes.
+func randomHex(ctx context.Context, n int) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("bytes must be greater than zero")
+	}
+	if n > maxHexBytes {
+		return "", fmt.Errorf("bytes cannot exceed %d", maxHexBytes)
+	}
+	return randomEncodedBytes(ctx, n, "hex")
+}
@@ -0,0 +1,104 @@
+package random
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomHexHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		bytes   int
+		wantErr bool
+	}{
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative bytes",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": -1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with bytes over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": maxHexBytes + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with one byte",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 1}}},
+			bytes:   1,
+		},
+		{
+			desc:    "valid request with 32 bytes",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 32}}},
+			bytes:   32,
+		},
+		{
+			desc:    "valid request at cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": maxHexBytes}}},
+			bytes:   maxHexBytes,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomHexHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomHexHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomHexHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomHexHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomHexHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomHexHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			if len(textContent.Text) != 2*tc.bytes {
+				t.Fatalf("randomHexHandler() text length = %d, want %d", len(textContent.Text), 2*tc.bytes)
+			}
+			decoded, err := hex.DecodeString(textContent.Text)
+			if err != nil {
+				t.Fatalf("randomHexHandler() invalid hex %q: %v", textContent.Text, err)
+			}
+			if len(decoded) != tc.bytes {
+				t.Fatalf("randomHexHandler() decoded length = %d, want %d", len(decoded), tc.bytes)
+			}
+
+			structured, ok := result.StructuredContent.(randomHexResponse)
+			if !ok {
+				t.Fatalf("randomHexHandler() structured content type = %T, want randomHexResponse", result.StructuredContent)
+			}
+			if structured.Value != textContent.Text {
+				t.Fatalf("randomHexHandler() structured value = %q, text value = %q", structured.Value, textContent.Text)
+			}
+			if structured.Bytes != tc.bytes {
+				t.Fatalf("randomHexHandler() structured bytes = %d, want %d", structured.Bytes, tc.bytes)
+			}
+		})
+	}
+}
+
+func TestRandomHexRejectsZeroBytes(t *testing.T) {
+	_, err := randomHex(t.Context(), 0)
+	if err == nil || !strings.Contains(err.Error(), "bytes") {
+		t.Fatalf("randomHex() error = %v, want one naming bytes", err)
+	}
+}
@@ -0,0 +1,124 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxHistogramCount caps the number of samples random_histogram will draw in a single call.
+	maxHistogramCount = 1000000
+	// maxHistogramBuckets caps the number of buckets random_histogram will report.
+	maxHistogramBuckets = 1000
+	// defaultHistogramBuckets is the bucket count used when the caller does not choose one.
+	defaultHistogramBuckets = 10
+)
+
+// randomHistogramResponse reports Count samples bucketed into len(Buckets) equal-width bins.
+// Bucket i covers [Edges[i], Edges[i+1]); the last bucket also includes its upper edge.
+type randomHistogramResponse struct {
+	Buckets       []int64   `json:"buckets"`
+	Edges         []float64 `json:"edges"`
+	Count         int       `json:"count"`
+	Distribution  string    `json:"distribution"`
+	SchemaVersion int       `json:"schemaVersion"`
+	Algorithm     string    `json:"algorithm"`
+}
+
+// randomHistogramArgs holds the parameters of every supported distribution; only those of the
+// chosen distribution are used. Uniform samples lie in [min, max) and default to [0, 1).
+type randomHistogramArgs struct {
+	Distribution string   `json:"distribution"`
+	Count        int      `json:"count"`
+	Buckets      *int     `json:"buckets,omitempty"`
+	Min          *float64 `json:"min,omitempty"`
+	Max          *float64 `json:"max,omitempty"`
+	Mean         *float64 `json:"mean,omitempty"`
+	StdDev       *float64 `json:"stddev,omitempty"`
+	Rate         *float64 `json:"rate,omitempty"`
+}
+
+func randomHistogramHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomHistogramArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_histogram", err), nil
+	}
+
+	buckets := defaultHistogramBuckets
+	if args.Buckets != nil {
+		buckets = *args.Buckets
+	}
+	if buckets <= 0 || buckets > maxHistogramBuckets {
+		return toolErrorResult("random_histogram", fmt.Errorf("buckets must be between 1 and %d", maxHistogramBuckets)), nil
+	}
+
+	samples, lo, hi, err := histogramSamples(ctx, args)
+	if err != nil {
+		return toolErrorResult("random_histogram", err), nil
+	}
+	counts, edges := histogram(samples, lo, hi, buckets)
+
+	lines := make([]string, buckets)
+	for i, count := range counts {
+		closing := ")"
+		if i == buckets-1 {
+			closing = "]"
+		}
+		lines[i] = fmt.Sprintf("[%g, %g%s: %d", edges[i], edges[i+1], closing, count)
+	}
+
+	response := randomHistogramResponse{Buckets: counts, Edges: edges, Count: len(samples), Distribution: args.Distribution, SchemaVersion: schemaVersions["random_histogram"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// histogramSamples draws args.Count samples from the named distribution and returns them with
+// the range the histogram should span: [min, max] for uniform samples and the observed extremes
+// for the unbounded distributions.
+func histogramSamples(ctx context.Context, args randomHistogramArgs) ([]float64, float64, float64, error) {
+	if args.Count <= 0 || args.Count > maxHistogramCount {
+		return nil, 0, 0, fmt.Errorf("count must be between 1 and %d", maxHistogramCount)
+	}
+
+	params := distributionParams{min: args.Min, max: args.Max, mean: args.Mean, stddev: args.StdDev, rate: args.Rate}
+	samples, err := sampleDistribution(ctx, args.Distribution, params, args.Count)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if args.Distribution == distributionUniform {
+		min, max := params.uniformBounds()
+		return samples, min, max, nil
+	}
+	return samples, slices.Min(samples), slices.Max(samples), nil
+}
+
+// histogram counts samples into buckets equal-width bins spanning [lo, hi] and returns the
+// counts with the buckets+1 bin edges. Samples equal to hi land in the last bin. The span is
+// handled in halves, since hi-lo overflows to +Inf for bounds near ±math.MaxFloat64.
+func histogram(samples []float64, lo, hi float64, buckets int) ([]int64, []float64) {
+	halfSpan := hi/2 - lo/2
+	edges := make([]float64, buckets+1)
+	for i := range edges {
+		offset := float64(i) / float64(buckets) * halfSpan
+		edges[i] = lo + offset + offset
+	}
+	edges[buckets] = hi
+
+	counts := make([]int64, buckets)
+	for _, sample := range samples {
+		index := 0
+		if halfSpan > 0 {
+			index = min(int((sample/2-lo/2)/halfSpan*float64(buckets)), buckets-1)
+		}
+		counts[max(index, 0)]++
+	}
+	return counts, edges
+}
@@ -0,0 +1,168 @@
+package random
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomHistogramHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		count   int
+		buckets int
+		firstLo float64
+		lastHi  float64
+		spanSet bool
+		wantErr bool
+	}{
+		{
+			desc:    "uniform with defaults",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 1000}}},
+			count:   1000,
+			buckets: defaultHistogramBuckets,
+			firstLo: 0,
+			lastHi:  1,
+			spanSet: true,
+		},
+		{
+			desc:    "uniform with range",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 500, "buckets": 4, "min": -2.0, "max": 2.0}}},
+			count:   500,
+			buckets: 4,
+			firstLo: -2,
+			lastHi:  2,
+			spanSet: true,
+		},
+		{
+			desc:    "normal",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "normal", "count": 2000, "buckets": 20, "mean": 50.0, "stddev": 5.0}}},
+			count:   2000,
+			buckets: 20,
+		},
+		{
+			desc:    "exponential with a single sample",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "exponential", "count": 1, "rate": 2.0}}},
+			count:   1,
+			buckets: defaultHistogramBuckets,
+		},
+		{
+			desc:    "uniform with range spanning the float64 limits",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 100, "buckets": 1, "min": -1e308, "max": 1e308}}},
+			count:   100,
+			buckets: 1,
+			firstLo: -1e308,
+			lastHi:  1e308,
+			spanSet: true,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with unknown distribution",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "cauchy", "count": 10}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with count over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": maxHistogramCount + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero buckets",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 10, "buckets": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with bad distribution params",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "normal", "count": 10, "stddev": -1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomHistogramHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomHistogramHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomHistogramHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomHistogramHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomHistogramHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomHistogramResponse)
+			if !ok {
+				t.Fatalf("randomHistogramHandler() structured content type = %T, want randomHistogramResponse", result.StructuredContent)
+			}
+			if structured.Count != tc.count || len(structured.Buckets) != tc.buckets || len(structured.Edges) != tc.buckets+1 {
+				t.Fatalf("randomHistogramHandler() count/buckets/edges = %d/%d/%d, want %d/%d/%d", structured.Count, len(structured.Buckets), len(structured.Edges), tc.count, tc.buckets, tc.buckets+1)
+			}
+			var total int64
+			for _, count := range structured.Buckets {
+				total += count
+			}
+			if total != int64(tc.count) {
+				t.Fatalf("randomHistogramHandler() bucket total = %d, want %d", total, tc.count)
+			}
+			if !slices.IsSorted(structured.Edges) {
+				t.Fatalf("randomHistogramHandler() edges not sorted: %v", structured.Edges)
+			}
+			if tc.spanSet && (structured.Edges[0] != tc.firstLo || structured.Edges[tc.buckets] != tc.lastHi) {
+				t.Fatalf("randomHistogramHandler() edges span [%g, %g], want [%g, %g]", structured.Edges[0], structured.Edges[tc.buckets], tc.firstLo, tc.lastHi)
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomHistogramHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			lines := strings.Split(textContent.Text, "\n")
+			if len(lines) != tc.buckets {
+				t.Fatalf("randomHistogramHandler() text has %d lines, want %d", len(lines), tc.buckets)
+			}
+			for i, line := range lines {
+				if closed := strings.Contains(line, "]"); closed != (i == tc.buckets-1) {
+					t.Fatalf("randomHistogramHandler() line %d = %q, want only the last bucket closed", i, line)
+				}
+			}
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	counts, edges := histogram([]float64{0, 0.5, 1, 1.5, 2, 2}, 0, 2, 4)
+	if want := []int64{1, 1, 1, 3}; !slices.Equal(counts, want) {
+		t.Fatalf("histogram() counts = %v, want %v", counts, want)
+	}
+	if want := []float64{0, 0.5, 1, 1.5, 2}; !slices.Equal(edges, want) {
+		t.Fatalf("histogram() edges = %v, want %v", edges, want)
+	}
+
+	counts, _ = histogram([]float64{3, 3, 3}, 3, 3, 5)
+	if want := []int64{3, 0, 0, 0, 0}; !slices.Equal(counts, want) {
+		t.Fatalf("histogram() of identical samples = %v, want %v", counts, want)
+	}
+
+	counts, edges = histogram([]float64{-math.MaxFloat64, 0, math.MaxFloat64}, -math.MaxFloat64, math.MaxFloat64, 2)
+	if want := []int64{1, 2}; !slices.Equal(counts, want) {
+		t.Fatalf("histogram() over the full float64 range counts = %v, want %v", counts, want)
+	}
+	if want := []float64{-math.MaxFloat64, 0, math.MaxFloat64}; !slices.Equal(edges, want) {
+		t.Fatalf("histogram() over the full float64 range edges = %v, want %v", edges, want)
+	}
+}
@@ -0,0 +1,79 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxIndexCount caps the number of indices random_index will generate in a single call.
+const maxIndexCount = 10000
+
+type randomIndexResponse struct {
+	Values        []int64 `json:"values"`
+	Size          int     `json:"size"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomIndexArgs struct {
+	Size  int  `json:"size"`
+	Count *int `json:"count,omitempty"`
+}
+
+func randomIndexHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomIndexArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_index", err), nil
+	}
+
+	count := 1
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	values, err := randomIndices(ctx, args.Size, count)
+	if err != nil {
+		return toolErrorResult("random_index", err), nil
+	}
+
+	lines := make([]string, len(values))
+	for i, value := range values {
+		lines[i] = strconv.FormatInt(value, 10)
+	}
+
+	response := randomIndexResponse{Values: values, Size: args.Size, SchemaVersion: schemaVersions["random_index"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomIndices returns count independent, uniformly distributed indices in [0, size-1].
+// Size must be greater than zero and count must be between 1 and maxIndexCount.
+func randomIndices(ctx context.Context, size, count int) ([]int64, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("size must be greater than zero")
+	}
+	if count <= 0 || count > maxIndexCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", maxIndexCount)
+	}
+
+	values := make([]int64, count)
+	for i := range values {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		value, err := sourceFromContext(ctx).Int64(0, int64(size-1))
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,105 @@
+package random
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomIndexHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		size    int
+		count   int
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with size only",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"size": 10}}},
+			size:    10,
+			count:   1,
+		},
+		{
+			desc:    "valid request with size one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"size": 1, "count": 3}}},
+			size:    1,
+			count:   3,
+		},
+		{
+			desc:    "valid request with maximum count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"size": 52, "count": maxIndexCount}}},
+			size:    52,
+			count:   maxIndexCount,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative size",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"size": -1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"size": 10, "count": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with count over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"size": 10, "count": maxIndexCount + 1}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomIndexHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomIndexHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomIndexHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomIndexHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomIndexHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomIndexResponse)
+			if !ok {
+				t.Fatalf("randomIndexHandler() structured content type = %T, want randomIndexResponse", result.StructuredContent)
+			}
+			if structured.Size != tc.size || len(structured.Values) != tc.count {
+				t.Fatalf("randomIndexHandler() size/count = %d/%d, want %d/%d", structured.Size, len(structured.Values), tc.size, tc.count)
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomIndexHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			lines := strings.Split(textContent.Text, "\n")
+			if len(lines) != tc.count {
+				t.Fatalf("randomIndexHandler() text has %d lines, want %d", len(lines), tc.count)
+			}
+			for i, value := range structured.Values {
+				if value < 0 || value >= int64(tc.size) {
+					t.Fatalf("randomIndexHandler() index %d out of range [0, %d)", value, tc.size)
+				}
+				if lines[i] != strconv.FormatInt(value, 10) {
+					t.Fatalf("randomIndexHandler() text line %q, want %d", lines[i], value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package random
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// inFlightMiddleware keeps counter equal to the number of tool handlers currently running.
+// A nil counter disables counting.
+func inFlightMiddleware(counter *atomic.Int64) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if counter == nil {
+			return next
+		}
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			counter.Add(1)
+			defer counter.Add(-1)
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package random
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestInFlightMiddleware(t *testing.T) {
+	const calls = 3
+
+	var counter atomic.Int64
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := inFlightMiddleware(&counter)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started <- struct{}{}
+		<-release
+		return mcp.NewToolResultText("done"), nil
+	})
+
+	var wg sync.WaitGroup
+	for i := range calls {
+		wg.Go(func() {
+			_, _ = handler(t.Context(), mcp.CallToolRequest{})
+		})
+		<-started
+		if got := counter.Load(); got != int64(i+1) {
+			t.Fatalf("in-flight count with %d calls running = %d", i+1, got)
+		}
+	}
+
+	close(release)
+	wg.Wait()
+	if got := counter.Load(); got != 0 {
+		t.Fatalf("in-flight count after every call returned = %d, want 0", got)
+	}
+}
+
+// blockingReader signals on started, then waits for release before supplying zero bytes, so a
+// tool call reading from it stays in flight for as long as the test needs.
+type blockingReader struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r blockingReader) Read(p []byte) (int, error) {
+	r.started <- struct{}{}
+	<-r.release
+	clear(p)
+	return len(p), nil
+}
+
+func TestNewMCPServerWithInFlightCounter(t *testing.T) {
+	var counter atomic.Int64
+	reader := blockingReader{started: make(chan struct{}), release: make(chan struct{})}
+	mcpServer := NewMCPServer("test-server", "0.0.0", WithInFlightCounter(&counter), WithRandReader(reader))
+
+	done := make(chan mcp.JSONRPCMessage)
+	go func() {
+		done <- mcpServer.HandleMessage(t.Context(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int","arguments":{"max":10}}}`))
+	}()
+	<-reader.started
+	if got := counter.Load(); got != 1 {
+		t.Fatalf("in-flight count during the call = %d, want 1", got)
+	}
+
+	close(reader.release)
+	response := <-done
+	if result, ok := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult); !ok || result.IsError {
+		t.Fatalf("HandleMessage() result = %+v, want success", response)
+	}
+	if got := counter.Load(); got != 0 {
+		t.Fatalf("in-flight count after the call returned = %d, want 0", got)
+	}
+}
@@ -0,0 +1,96 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+var (
+	// defaultIPv4Prefix and defaultIPv6Prefix cover the whole address space of each version.
+	defaultIPv4Prefix = netip.MustParsePrefix("0.0.0.0/0")
+	defaultIPv6Prefix = netip.MustParsePrefix("::/0")
+)
+
+type randomIPResponse struct {
+	Value         string `json:"value"`
+	CIDR          string `json:"cidr"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomIPArgs struct {
+	CIDR    string `json:"cidr,omitempty"`
+	Version int    `json:"version,omitempty"`
+}
+
+func randomIPHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomIPArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_ip", err), nil
+	}
+
+	prefix, err := ipPrefix(args.CIDR, args.Version)
+	if err != nil {
+		return toolErrorResult("random_ip", err), nil
+	}
+
+	addr, err := randomIPInPrefix(ctx, prefix)
+	if err != nil {
+		return toolErrorResult("random_ip", err), nil
+	}
+
+	value := addr.String()
+	response := randomIPResponse{Value: value, CIDR: prefix.String(), SchemaVersion: schemaVersions["random_ip"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// ipPrefix resolves the prefix to draw from. The version is inferred from cidr when
+// given, must agree with it when both are set, and defaults to 4 when neither is set.
+func ipPrefix(cidr string, version int) (netip.Prefix, error) {
+	if version != 0 && version != 4 && version != 6 {
+		return netip.Prefix{}, fmt.Errorf("unsupported version %d: must be 4 or 6", version)
+	}
+
+	if cidr == "" {
+		if version == 6 {
+			return defaultIPv6Prefix, nil
+		}
+		return defaultIPv4Prefix, nil
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q: %w", cidr, err)
+	}
+	prefix = prefix.Masked()
+	if prefix.Addr().Is4() && version == 6 || prefix.Addr().Is6() && version == 4 {
+		return netip.Prefix{}, fmt.Errorf("cidr %q does not match IP version %d", cidr, version)
+	}
+	return prefix, nil
+}
+
+// randomIPInPrefix keeps the network bits of prefix and fills the host bits from the context's source.
+func randomIPInPrefix(ctx context.Context, prefix netip.Prefix) (netip.Addr, error) {
+	network := prefix.Addr().AsSlice()
+	host := make([]byte, len(network))
+	if _, err := sourceFromContext(ctx).Read(host); err != nil {
+		return netip.Addr{}, err
+	}
+
+	for i := range network {
+		networkBits := min(max(prefix.Bits()-8*i, 0), 8)
+		mask := byte(0xff << (8 - networkBits))
+		network[i] = network[i]&mask | host[i]&^mask
+	}
+
+	addr, _ := netip.AddrFromSlice(network)
+	return addr, nil
+}
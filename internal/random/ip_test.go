@@ -0,0 +1,137 @@
+package random
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomIPHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		cidr    string
+		is6     bool
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			cidr:    "0.0.0.0/0",
+		},
+		{
+			desc:    "valid request for version 6",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"version": 6}}},
+			cidr:    "::/0",
+			is6:     true,
+		},
+		{
+			desc:    "valid request with ipv4 cidr",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "10.0.0.0/8"}}},
+			cidr:    "10.0.0.0/8",
+		},
+		{
+			desc:    "valid request with unaligned ipv4 cidr",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "192.168.1.77/27", "version": 4}}},
+			cidr:    "192.168.1.64/27",
+		},
+		{
+			desc:    "valid request with ipv6 cidr",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "2001:db8::/61"}}},
+			cidr:    "2001:db8::/61",
+			is6:     true,
+		},
+		{
+			desc:    "valid request with single-address ipv4 cidr",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "10.0.0.1/32"}}},
+			cidr:    "10.0.0.1/32",
+		},
+		{
+			desc:    "valid request with single-address ipv6 cidr",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "2001:db8::1/128"}}},
+			cidr:    "2001:db8::1/128",
+			is6:     true,
+		},
+		{
+			desc:    "invalid request with malformed cidr",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "10.0.0.0/33"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with mismatched version",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cidr": "10.0.0.0/8", "version": 6}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with unknown version",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"version": 5}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for range 20 {
+				result, err := randomIPHandler(ctx, tc.request)
+				if err != nil {
+					t.Fatalf("randomIPHandler() error = %v", err)
+				}
+				if result == nil || len(result.Content) == 0 {
+					t.Fatalf("randomIPHandler() result is nil or empty")
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomIPHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomIPHandler() returned error content: %+v", result.Content[0])
+				}
+
+				textContent, ok := result.Content[0].(mcp.TextContent)
+				if !ok {
+					t.Fatalf("randomIPHandler() content type = %T, want TextContent", result.Content[0])
+				}
+				addr, err := netip.ParseAddr(textContent.Text)
+				if err != nil {
+					t.Fatalf("randomIPHandler() invalid address %q: %v", textContent.Text, err)
+				}
+				if addr.Is6() != tc.is6 {
+					t.Fatalf("randomIPHandler() address %s is6 = %v, want %v", addr, addr.Is6(), tc.is6)
+				}
+				if !netip.MustParsePrefix(tc.cidr).Contains(addr) {
+					t.Fatalf("randomIPHandler() address %s outside %s", addr, tc.cidr)
+				}
+
+				structured, ok := result.StructuredContent.(randomIPResponse)
+				if !ok {
+					t.Fatalf("randomIPHandler() structured content type = %T, want randomIPResponse", result.StructuredContent)
+				}
+				if structured.Value != textContent.Text || structured.CIDR != tc.cidr {
+					t.Fatalf("randomIPHandler() structured = %+v, want value %s cidr %s", structured, textContent.Text, tc.cidr)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomIPInPrefixRandomizesHostBits(t *testing.T) {
+	prefix := netip.MustParsePrefix("172.16.0.0/30")
+	seen := map[netip.Addr]bool{}
+	for range 200 {
+		addr, err := randomIPInPrefix(t.Context(), prefix)
+		if err != nil {
+			t.Fatalf("randomIPInPrefix() error = %v", err)
+		}
+		if !prefix.Contains(addr) {
+			t.Fatalf("randomIPInPrefix() = %s, outside %s", addr, prefix)
+		}
+		seen[addr] = true
+	}
+	if len(seen) != 4 {
+		t.Fatalf("randomIPInPrefix() produced %d distinct addresses in %s, want 4", len(seen), prefix)
+	}
+}
@@ -0,0 +1,69 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomJitterResponse struct {
+	DurationMillis int64  `json:"durationMillis"`
+	Unit           string `json:"unit"`
+	Duration       string `json:"duration"`
+	SchemaVersion  int    `json:"schemaVersion"`
+	Algorithm      string `json:"algorithm"`
+}
+
+type randomJitterArgs struct {
+	Base   string  `json:"base"`
+	Jitter float64 `json:"jitter"`
+}
+
+func randomJitterHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomJitterArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_jitter", err), nil
+	}
+
+	base, err := time.ParseDuration(args.Base)
+	if err != nil {
+		return toolErrorResult("random_jitter", err), nil
+	}
+
+	duration, err := randomJitter(ctx, base, args.Jitter)
+	if err != nil {
+		return toolErrorResult("random_jitter", err), nil
+	}
+
+	response := randomJitterResponse{DurationMillis: duration.Milliseconds(), Unit: unitMilliseconds, Duration: duration.String(), SchemaVersion: schemaVersions["random_jitter"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: duration.String()},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomJitter returns a duration drawn uniformly from [base*(1-jitter), base*(1+jitter)).
+// Base must be greater than zero and jitter must lie in [0, 1].
+func randomJitter(ctx context.Context, base time.Duration, jitter float64) (time.Duration, error) {
+	if base <= 0 {
+		return 0, fmt.Errorf("base must be greater than zero")
+	}
+	if math.IsNaN(jitter) || jitter < 0 || jitter > 1 {
+		return 0, fmt.Errorf("jitter must be between 0 and 1")
+	}
+	if float64(base)*(1+jitter) >= math.MaxInt64 {
+		return 0, fmt.Errorf("base %s with jitter %g overflows the duration range", base, jitter)
+	}
+
+	u, err := sourceFromContext(ctx).UnitFloat64()
+	if err != nil {
+		return 0, err
+	}
+	factor := 1 + jitter*(2*u-1)
+	return time.Duration(float64(base) * factor), nil
+}
@@ -0,0 +1,116 @@
+package random
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomJitterHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		min     time.Duration
+		max     time.Duration
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with twenty percent jitter",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "5s", "jitter": 0.2}}},
+			min:     4 * time.Second,
+			max:     6 * time.Second,
+		},
+		{
+			desc:    "valid request with zero jitter",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "250ms", "jitter": 0.0}}},
+			min:     250 * time.Millisecond,
+			max:     250 * time.Millisecond,
+		},
+		{
+			desc:    "valid request with full jitter",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "1m", "jitter": 1.0}}},
+			min:     0,
+			max:     2 * time.Minute,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with malformed base",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "five seconds", "jitter": 0.2}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero base",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "0s", "jitter": 0.2}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative base",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "-5s", "jitter": 0.2}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with jitter above one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "5s", "jitter": 1.5}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative jitter",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "5s", "jitter": -0.1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request that overflows",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"base": "2000000h", "jitter": 1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomJitterHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomJitterHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomJitterHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomJitterHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomJitterHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomJitterResponse)
+			if !ok {
+				t.Fatalf("randomJitterHandler() structured content type = %T, want randomJitterResponse", result.StructuredContent)
+			}
+			duration, err := time.ParseDuration(structured.Duration)
+			if err != nil {
+				t.Fatalf("randomJitterHandler() invalid duration %q: %v", structured.Duration, err)
+			}
+			if duration < tc.min || duration > tc.max {
+				t.Fatalf("randomJitterHandler() duration %s out of range [%s, %s]", duration, tc.min, tc.max)
+			}
+			if structured.DurationMillis != duration.Milliseconds() {
+				t.Fatalf("randomJitterHandler() durationMillis = %d, want %d", structured.DurationMillis, duration.Milliseconds())
+			}
+			if structured.Unit != unitMilliseconds {
+				t.Fatalf("randomJitterHandler() unit = %q, want %q", structured.Unit, unitMilliseconds)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Duration {
+				t.Fatalf("randomJitterHandler() text content = %+v, want %q", result.Content[0], structured.Duration)
+			}
+		})
+	}
+}
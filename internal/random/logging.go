@@ -0,0 +1,34 @@
+package random
+
+import "log/slog"
+
+// redactedPlaceholder replaces sensitive values in log output.
+const redactedPlaceholder = "[REDACTED]"
+
+// sensitiveTools lists the tools whose output is a secret. Their handlers may log
+// metadata such as length or charset, but never the generated value itself.
+var sensitiveTools = map[string]bool{
+	"random_ascii":      true,
+	"random_string":     true,
+	"random_bytes":      true,
+	"random_password":   true,
+	"random_hex":        true,
+	"random_passphrase": true,
+	"random_token":      true,
+}
+
+// redacted wraps a value so that slog renders it as redactedPlaceholder.
+type redacted string
+
+func (redacted) LogValue() slog.Value {
+	return slog.StringValue(redactedPlaceholder)
+}
+
+// resultAttr returns the "result" log attribute for a tool's output, redacting the
+// value when the tool is listed in sensitiveTools.
+func resultAttr(tool, value string) slog.Attr {
+	if sensitiveTools[tool] {
+		return slog.Any("result", redacted(value))
+	}
+	return slog.String("result", value)
+}
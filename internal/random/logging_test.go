@@ -0,0 +1,94 @@
+package random
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSensitiveHandlersRedactLoggedValues(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		request mcp.CallToolRequest
+	}{
+		{
+			desc:    "random_ascii",
+			handler: randomASCIIHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32}}},
+		},
+		{
+			desc:    "random_string",
+			handler: randomStringHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32, "preset": "alphanumeric"}}},
+		},
+		{
+			desc:    "random_bytes",
+			handler: randomBytesHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32}}},
+		},
+		{
+			desc:    "random_password",
+			handler: randomPasswordHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32}}},
+		},
+		{
+			desc:    "random_hex",
+			handler: randomHexHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 32}}},
+		},
+		{
+			desc:    "random_passphrase",
+			handler: randomPassphraseHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 6}}},
+		},
+		{
+			desc:    "random_token",
+			handler: randomTokenHandler,
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 32}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			previous := slog.Default()
+			slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+			t.Cleanup(func() { slog.SetDefault(previous) })
+
+			result, err := tc.handler(t.Context(), tc.request)
+			if err != nil {
+				t.Fatalf("%s handler error = %v", tc.desc, err)
+			}
+			if result.IsError {
+				t.Fatalf("%s handler returned error content: %+v", tc.desc, result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("%s handler content type = %T, want TextContent", tc.desc, result.Content[0])
+			}
+
+			logged := buf.String()
+			if strings.Contains(logged, textContent.Text) {
+				t.Fatalf("%s handler logged its sensitive value: %s", tc.desc, logged)
+			}
+			if !strings.Contains(logged, redactedPlaceholder) {
+				t.Fatalf("%s handler log output missing %q: %s", tc.desc, redactedPlaceholder, logged)
+			}
+		})
+	}
+}
+
+func TestResultAttr(t *testing.T) {
+	if got := resultAttr("random_int", "42").Value.Resolve().String(); got != "42" {
+		t.Fatalf("resultAttr() for non-sensitive tool = %q, want %q", got, "42")
+	}
+	if got := resultAttr("random_password", "hunter2").Value.Resolve().String(); got != redactedPlaceholder {
+		t.Fatalf("resultAttr() for sensitive tool = %q, want %q", got, redactedPlaceholder)
+	}
+}
@@ -0,0 +1,153 @@
+package random
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Caps on the amount of text random_lorem will generate in a single call.
+const (
+	maxLoremWords     = 10000
+	maxLoremSentences = 1000
+)
+
+// Sentence lengths are drawn uniformly from [minLoremSentenceWords, maxLoremSentenceWords].
+const (
+	minLoremSentenceWords = 4
+	maxLoremSentenceWords = 12
+)
+
+// loremWordListData is the vocabulary of the traditional lorem ipsum passage.
+//
+//go:embed loremwords.txt
+var loremWordListData string
+
+// loremWordList holds the embedded lorem ipsum words.
+var loremWordList = strings.Fields(loremWordListData)
+
+type randomLoremResponse struct {
+	Value         string `json:"value"`
+	WordCount     int    `json:"wordCount"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+// randomLoremArgs sizes the text by either Words or Sentences; exactly one must be set.
+type randomLoremArgs struct {
+	Words     *int `json:"words,omitempty"`
+	Sentences *int `json:"sentences,omitempty"`
+}
+
+func randomLoremHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomLoremArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_lorem", err), nil
+	}
+
+	var sentences [][]string
+	var err error
+	switch {
+	case (args.Words == nil) == (args.Sentences == nil):
+		err = fmt.Errorf("exactly one of words or sentences must be set")
+	case args.Words != nil:
+		if *args.Words <= 0 || *args.Words > maxLoremWords {
+			err = fmt.Errorf("words must be between 1 and %d", maxLoremWords)
+			break
+		}
+		sentences, err = randomLoremWords(ctx, *args.Words)
+	default:
+		if *args.Sentences <= 0 || *args.Sentences > maxLoremSentences {
+			err = fmt.Errorf("sentences must be between 1 and %d", maxLoremSentences)
+			break
+		}
+		sentences, err = randomLoremSentences(ctx, *args.Sentences)
+	}
+	if err != nil {
+		return toolErrorResult("random_lorem", err), nil
+	}
+
+	value := formatLorem(sentences)
+	wordCount := 0
+	for _, sentence := range sentences {
+		wordCount += len(sentence)
+	}
+
+	response := randomLoremResponse{Value: value, WordCount: wordCount, SchemaVersion: schemaVersions["random_lorem"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomLoremSentences returns count sentences, each a random number of words between
+// minLoremSentenceWords and maxLoremSentenceWords.
+func randomLoremSentences(ctx context.Context, count int) ([][]string, error) {
+	sentences := make([][]string, count)
+	for i := range sentences {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		length, err := sourceFromContext(ctx).Int64(minLoremSentenceWords, maxLoremSentenceWords)
+		if err != nil {
+			return nil, err
+		}
+		sentences[i], err = randomLoremSentence(ctx, int(length))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sentences, nil
+}
+
+// randomLoremWords returns exactly count words split into sentences of random length; the
+// last sentence is shortened as needed.
+func randomLoremWords(ctx context.Context, count int) ([][]string, error) {
+	var sentences [][]string
+	for remaining := count; remaining > 0; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		length, err := sourceFromContext(ctx).Int64(minLoremSentenceWords, maxLoremSentenceWords)
+		if err != nil {
+			return nil, err
+		}
+		n := min(int(length), remaining)
+		sentence, err := randomLoremSentence(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		sentences = append(sentences, sentence)
+		remaining -= n
+	}
+	return sentences, nil
+}
+
+// randomLoremSentence returns length words drawn independently and uniformly from loremWordList.
+func randomLoremSentence(ctx context.Context, length int) ([]string, error) {
+	words := make([]string, length)
+	for i := range words {
+		index, err := sourceFromContext(ctx).Int64(0, int64(len(loremWordList)-1))
+		if err != nil {
+			return nil, err
+		}
+		words[i] = loremWordList[index]
+	}
+	return words, nil
+}
+
+// formatLorem joins sentences into text, capitalizing the first word of each sentence and
+// ending each with a period.
+func formatLorem(sentences [][]string) string {
+	formatted := make([]string, len(sentences))
+	for i, sentence := range sentences {
+		text := strings.Join(sentence, " ")
+		formatted[i] = strings.ToUpper(text[:1]) + text[1:] + "."
+	}
+	return strings.Join(formatted, " ")
+}
@@ -0,0 +1,114 @@
+package random
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomLoremHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		request   mcp.CallToolRequest
+		words     int
+		sentences int
+		wantErr   bool
+	}{
+		{
+			desc:    "valid request with words",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 50}}},
+			words:   50,
+		},
+		{
+			desc:    "valid request with a single word",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 1}}},
+			words:   1,
+		},
+		{
+			desc:      "valid request with sentences",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"sentences": 5}}},
+			sentences: 5,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with words and sentences",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 5, "sentences": 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero words",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with too many words",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": maxLoremWords + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with too many sentences",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"sentences": maxLoremSentences + 1}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomLoremHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomLoremHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomLoremHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomLoremHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomLoremHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomLoremResponse)
+			if !ok {
+				t.Fatalf("randomLoremHandler() structured content type = %T, want randomLoremResponse", result.StructuredContent)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Value {
+				t.Fatalf("randomLoremHandler() text content = %+v, want %q", result.Content[0], structured.Value)
+			}
+
+			words := strings.Fields(structured.Value)
+			if len(words) != structured.WordCount {
+				t.Fatalf("randomLoremHandler() wordCount = %d, but value has %d words", structured.WordCount, len(words))
+			}
+			if tc.words > 0 && structured.WordCount != tc.words {
+				t.Fatalf("randomLoremHandler() wordCount = %d, want %d", structured.WordCount, tc.words)
+			}
+			if !strings.HasSuffix(structured.Value, ".") {
+				t.Fatalf("randomLoremHandler() value %q does not end with a period", structured.Value)
+			}
+			sentences := strings.Split(strings.TrimSuffix(structured.Value, "."), ". ")
+			if tc.sentences > 0 && len(sentences) != tc.sentences {
+				t.Fatalf("randomLoremHandler() returned %d sentences, want %d", len(sentences), tc.sentences)
+			}
+			for _, sentence := range sentences {
+				if !unicode.IsUpper(rune(sentence[0])) {
+					t.Fatalf("randomLoremHandler() sentence %q is not capitalized", sentence)
+				}
+				if n := len(strings.Fields(sentence)); n > maxLoremSentenceWords || (tc.sentences > 0 && n < minLoremSentenceWords) {
+					t.Fatalf("randomLoremHandler() sentence %q has %d words", sentence, n)
+				}
+			}
+		})
+	}
+}
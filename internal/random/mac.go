@@ -0,0 +1,74 @@
+package random
+
+import (
+	"context"
+	"net"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	macMulticastBit = 0x01
+	macLocalBit     = 0x02
+)
+
+type randomMACResponse struct {
+	Value               string `json:"value"`
+	Multicast           bool   `json:"multicast"`
+	LocallyAdministered bool   `json:"locallyAdministered"`
+	SchemaVersion       int    `json:"schemaVersion"`
+	Algorithm           string `json:"algorithm"`
+}
+
+type randomMACArgs struct {
+	Multicast *bool `json:"multicast,omitempty"`
+	Local     *bool `json:"local,omitempty"`
+}
+
+func randomMACHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomMACArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_mac", err), nil
+	}
+
+	multicast := false
+	local := true
+	if args.Multicast != nil {
+		multicast = *args.Multicast
+	}
+	if args.Local != nil {
+		local = *args.Local
+	}
+
+	mac, err := randomMAC(ctx, multicast, local)
+	if err != nil {
+		return toolErrorResult("random_mac", err), nil
+	}
+
+	value := mac.String()
+	response := randomMACResponse{Value: value, Multicast: multicast, LocallyAdministered: local, SchemaVersion: schemaVersions["random_mac"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomMAC returns a random 48-bit MAC address whose first octet has the
+// multicast (I/G) and locally administered (U/L) bits set as requested.
+func randomMAC(ctx context.Context, multicast, local bool) (net.HardwareAddr, error) {
+	mac := make(net.HardwareAddr, 6)
+	if _, err := sourceFromContext(ctx).Read(mac); err != nil {
+		return nil, err
+	}
+
+	mac[0] &^= macMulticastBit | macLocalBit
+	if multicast {
+		mac[0] |= macMulticastBit
+	}
+	if local {
+		mac[0] |= macLocalBit
+	}
+	return mac, nil
+}
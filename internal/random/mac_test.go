@@ -0,0 +1,82 @@
+package random
+
+import (
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+var macPattern = regexp.MustCompile(`^[0-9a-f]{2}(:[0-9a-f]{2}){5}$`)
+
+func TestRandomMACHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		request   mcp.CallToolRequest
+		multicast bool
+		local     bool
+	}{
+		{
+			desc:    "valid request with defaults",
+			request: mcp.CallToolRequest{},
+			local:   true,
+		},
+		{
+			desc:    "valid request for universal unicast",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"local": false}}},
+		},
+		{
+			desc:      "valid request for local multicast",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"multicast": true}}},
+			multicast: true,
+			local:     true,
+		},
+		{
+			desc:      "valid request for universal multicast",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"multicast": true, "local": false}}},
+			multicast: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for range 50 {
+				result, err := randomMACHandler(ctx, tc.request)
+				if err != nil {
+					t.Fatalf("randomMACHandler() error = %v", err)
+				}
+				if result.IsError {
+					t.Fatalf("randomMACHandler() returned error content: %+v", result.Content[0])
+				}
+
+				textContent, ok := result.Content[0].(mcp.TextContent)
+				if !ok {
+					t.Fatalf("randomMACHandler() content type = %T, want TextContent", result.Content[0])
+				}
+				if !macPattern.MatchString(textContent.Text) {
+					t.Fatalf("randomMACHandler() text = %q, want colon-separated lowercase hex", textContent.Text)
+				}
+				mac, err := net.ParseMAC(textContent.Text)
+				if err != nil {
+					t.Fatalf("randomMACHandler() invalid MAC %q: %v", textContent.Text, err)
+				}
+				if got := mac[0]&macMulticastBit != 0; got != tc.multicast {
+					t.Fatalf("randomMACHandler() %s multicast bit = %v, want %v", textContent.Text, got, tc.multicast)
+				}
+				if got := mac[0]&macLocalBit != 0; got != tc.local {
+					t.Fatalf("randomMACHandler() %s local bit = %v, want %v", textContent.Text, got, tc.local)
+				}
+
+				structured, ok := result.StructuredContent.(randomMACResponse)
+				if !ok {
+					t.Fatalf("randomMACHandler() structured content type = %T, want randomMACResponse", result.StructuredContent)
+				}
+				if structured.Value != textContent.Text || structured.Multicast != tc.multicast || structured.LocallyAdministered != tc.local {
+					t.Fatalf("randomMACHandler() structured = %+v, want value %s multicast %v local %v", structured, textContent.Text, tc.multicast, tc.local)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package random
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// randomMapChoiceResponse reports the chosen entry and its position in keys and values.
+type randomMapChoiceResponse struct {
+	Key           string `json:"key"`
+	Value         string `json:"value"`
+	Index         int    `json:"index"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+// randomMapChoiceArgs describes a map as parallel slices: Values[i] is the payload for Keys[i].
+type randomMapChoiceArgs struct {
+	Keys   []string `json:"keys"`
+	Values []string `json:"values"`
+}
+
+func randomMapChoiceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomMapChoiceArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_map_choice", err), nil
+	}
+
+	index, err := randomMapChoice(ctx, args.Keys, args.Values)
+	if err != nil {
+		return toolErrorResult("random_map_choice", err), nil
+	}
+
+	key, value := args.Keys[index], args.Values[index]
+	response := randomMapChoiceResponse{Key: key, Value: value, Index: index, SchemaVersion: schemaVersions["random_map_choice"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%s=%s", key, value)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomMapChoice returns the index of a uniformly chosen entry of the map given by keys and
+// values. The slices must be non-empty and the same length, and keys must be unique.
+func randomMapChoice(ctx context.Context, keys, values []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, fmt.Errorf("keys must not be empty")
+	}
+	if len(keys) != len(values) {
+		return 0, fmt.Errorf("keys and values must have the same length, got %d keys and %d values", len(keys), len(values))
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			return 0, fmt.Errorf("duplicate key %q", key)
+		}
+		seen[key] = true
+	}
+	return randomIndex(ctx, len(keys))
+}
@@ -0,0 +1,103 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomMapChoiceHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		entries map[string]string
+		wantErr bool
+	}{
+		{
+			desc:    "valid request",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"keys": []string{"red", "green", "blue"}, "values": []string{"#f00", "#0f0", "#00f"}}}},
+			entries: map[string]string{"red": "#f00", "green": "#0f0", "blue": "#00f"},
+		},
+		{
+			desc:    "valid request with one entry",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"keys": []string{"only"}, "values": []string{""}}}},
+			entries: map[string]string{"only": ""},
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with more keys than values",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"keys": []string{"a", "b"}, "values": []string{"1"}}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with duplicate keys",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"keys": []string{"a", "a"}, "values": []string{"1", "2"}}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomMapChoiceHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomMapChoiceHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomMapChoiceHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomMapChoiceHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomMapChoiceHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomMapChoiceResponse)
+			if !ok {
+				t.Fatalf("randomMapChoiceHandler() structured content type = %T, want randomMapChoiceResponse", result.StructuredContent)
+			}
+			value, ok := tc.entries[structured.Key]
+			if !ok || value != structured.Value {
+				t.Fatalf("randomMapChoiceHandler() chose %q=%q, not an entry of %v", structured.Key, structured.Value, tc.entries)
+			}
+			keys := tc.request.GetArguments()["keys"].([]string)
+			if structured.Index < 0 || structured.Index >= len(keys) || keys[structured.Index] != structured.Key {
+				t.Fatalf("randomMapChoiceHandler() index %d does not hold key %q", structured.Index, structured.Key)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Key+"="+structured.Value {
+				t.Fatalf("randomMapChoiceHandler() text content = %+v, want %s=%s", result.Content[0], structured.Key, structured.Value)
+			}
+		})
+	}
+}
+
+func TestRandomMapChoiceCoversEveryEntry(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e"}
+	values := []string{"1", "2", "3", "4", "5"}
+	seen := make([]int, len(keys))
+	for range 1000 {
+		index, err := randomMapChoice(t.Context(), keys, values)
+		if err != nil {
+			t.Fatalf("randomMapChoice() error = %v", err)
+		}
+		if index < 0 || index >= len(keys) {
+			t.Fatalf("randomMapChoice() index %d out of range [0, %d)", index, len(keys))
+		}
+		seen[index]++
+	}
+	// Each entry is expected 200 times; missing one entirely has probability about 5e-97.
+	for i, count := range seen {
+		if count == 0 {
+			t.Fatalf("randomMapChoice() never chose entry %d (%s) in 1000 draws", i, keys[i])
+		}
+	}
+}
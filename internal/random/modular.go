@@ -0,0 +1,67 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomModularResponse struct {
+	Value         int64  `json:"value"`
+	Modulus       int64  `json:"modulus"`
+	Offset        int64  `json:"offset"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomModularArgs struct {
+	Modulus int64 `json:"modulus"`
+	Offset  int64 `json:"offset,omitempty"`
+}
+
+func randomModularHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomModularArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_modular", err), nil
+	}
+
+	value, err := randomModular(ctx, args.Modulus, args.Offset)
+	if err != nil {
+		return toolErrorResult("random_modular", err), nil
+	}
+
+	response := randomModularResponse{Value: value, Modulus: args.Modulus, Offset: args.Offset, SchemaVersion: schemaVersions["random_modular"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(value, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomModular returns (u + offset) mod modulus for u drawn uniformly from [0, modulus-1], so
+// the result is also uniform over [0, modulus-1]; offset only rotates where the cycle starts.
+// Offset may be negative or exceed modulus. Modulus must be greater than zero.
+func randomModular(ctx context.Context, modulus, offset int64) (int64, error) {
+	if modulus <= 0 {
+		return 0, fmt.Errorf("modulus must be greater than zero")
+	}
+
+	u, err := sourceFromContext(ctx).Int64(0, modulus-1)
+	if err != nil {
+		return 0, err
+	}
+
+	// Reduce offset into [0, modulus-1] and wrap without forming u+shift, which can overflow
+	// for moduli above MaxInt64/2.
+	shift := offset % modulus
+	if shift < 0 {
+		shift += modulus
+	}
+	if u >= modulus-shift {
+		return u - (modulus - shift), nil
+	}
+	return u + shift, nil
+}
@@ -0,0 +1,121 @@
+package random
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomModularHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		modulus int64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request for an hour of the day",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"modulus": 24}}},
+			modulus: 24,
+		},
+		{
+			desc:    "valid request with offset",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"modulus": 12, "offset": 30}}},
+			modulus: 12,
+		},
+		{
+			desc:    "valid request with negative offset",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"modulus": 60, "offset": -7}}},
+			modulus: 60,
+		},
+		{
+			desc:    "valid request with modulus one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"modulus": 1, "offset": 5}}},
+			modulus: 1,
+		},
+		{
+			desc:    "valid request with maximum modulus",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"modulus": int64(math.MaxInt64), "offset": int64(math.MaxInt64 - 1)}}},
+			modulus: math.MaxInt64,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative modulus",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"modulus": -5}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomModularHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomModularHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomModularHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomModularHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomModularHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomModularResponse)
+			if !ok {
+				t.Fatalf("randomModularHandler() structured content type = %T, want randomModularResponse", result.StructuredContent)
+			}
+			if structured.Modulus != tc.modulus {
+				t.Fatalf("randomModularHandler() modulus = %d, want %d", structured.Modulus, tc.modulus)
+			}
+			if structured.Value < 0 || structured.Value >= tc.modulus {
+				t.Fatalf("randomModularHandler() value %d out of range [0, %d)", structured.Value, tc.modulus)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != strconv.FormatInt(structured.Value, 10) {
+				t.Fatalf("randomModularHandler() text content = %+v, want %d", result.Content[0], structured.Value)
+			}
+		})
+	}
+}
+
+func TestRandomModularWrapsOffset(t *testing.T) {
+	// crypto/rand.Int reads one byte for a modulus of 24 and masks it to 5 bits, so 0x15
+	// draws 21 and the offset wraps it around the cycle.
+	testCases := []struct {
+		desc   string
+		offset int64
+		want   int64
+	}{
+		{desc: "no offset", offset: 0, want: 21},
+		{desc: "offset wraps past the end", offset: 5, want: 2},
+		{desc: "negative offset", offset: -22, want: 23},
+		{desc: "offset larger than modulus", offset: 24*3 + 3, want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := withSource(t.Context(), securerand.New(bytes.NewReader([]byte{0x15})))
+			value, err := randomModular(ctx, 24, tc.offset)
+			if err != nil {
+				t.Fatalf("randomModular() error = %v", err)
+			}
+			if value != tc.want {
+				t.Fatalf("randomModular(24, %d) = %d, want %d", tc.offset, value, tc.want)
+			}
+		})
+	}
+}
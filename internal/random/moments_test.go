@@ -0,0 +1,108 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+)
+
+// momentSamples is the sample size drawn for each distribution in TestDistributionMoments.
+const momentSamples = 40000
+
+// TestDistributionMoments checks each distribution's sample mean and variance against the
+// exact moments. Every case draws from a fixed ChaCha8 seed, so the result is deterministic and a
+// failure points at the distribution math rather than at an unlucky run.
+//
+// Tolerances are five standard errors. The sample mean has standard error sqrt(variance/n); the
+// sample variance has standard error variance*sqrt((kurtosis-1)/n), where kurtosis is the
+// distribution's fourth standardized moment.
+func TestDistributionMoments(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		draw     func(ctx context.Context) (float64, error)
+		mean     float64
+		variance float64
+		kurtosis float64
+	}{
+		{
+			desc: "uniform",
+			draw: func(ctx context.Context) (float64, error) {
+				return sourceFromContext(ctx).Float64(-2, 6, true, false)
+			},
+			mean:     2,
+			variance: 64.0 / 12,
+			kurtosis: 1.8,
+		},
+		{
+			desc: "normal",
+			draw: func(ctx context.Context) (float64, error) {
+				return randomGaussian(ctx, 10, 3)
+			},
+			mean:     10,
+			variance: 9,
+			kurtosis: 3,
+		},
+		{
+			desc: "exponential",
+			draw: func(ctx context.Context) (float64, error) {
+				return randomExponential(ctx, 0.5)
+			},
+			mean:     2,
+			variance: 4,
+			kurtosis: 9,
+		},
+		{
+			desc: "poisson below the normal threshold",
+			draw: func(ctx context.Context) (float64, error) {
+				value, err := randomPoisson(ctx, 4)
+				return float64(value), err
+			},
+			mean:     4,
+			variance: 4,
+			kurtosis: 3 + 1.0/4,
+		},
+		{
+			desc: "poisson above the normal threshold",
+			draw: func(ctx context.Context) (float64, error) {
+				value, err := randomPoisson(ctx, 200)
+				return float64(value), err
+			},
+			mean:     200,
+			variance: 200,
+			kurtosis: 3 + 1.0/200,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var seed [32]byte
+			copy(seed[:], fmt.Sprintf("moments-%d", i))
+			ctx := withSource(t.Context(), securerand.New(rand.NewChaCha8(seed)))
+
+			var sum, sumSquares float64
+			for range momentSamples {
+				value, err := tc.draw(ctx)
+				if err != nil {
+					t.Fatalf("draw error = %v", err)
+				}
+				sum += value
+				sumSquares += value * value
+			}
+			mean := sum / momentSamples
+			variance := (sumSquares - sum*mean) / (momentSamples - 1)
+
+			meanTolerance := 5 * math.Sqrt(tc.variance/momentSamples)
+			if math.Abs(mean-tc.mean) > meanTolerance {
+				t.Fatalf("sample mean = %.4f, want within %.4f of %g", mean, meanTolerance, tc.mean)
+			}
+			varianceTolerance := 5 * tc.variance * math.Sqrt((tc.kurtosis-1)/momentSamples)
+			if math.Abs(variance-tc.variance) > varianceTolerance {
+				t.Fatalf("sample variance = %.4f, want within %.4f of %g", variance, varianceTolerance, tc.variance)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package random
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Styles accepted by random_name.
+const (
+	nameStyleFull  = "full"
+	nameStyleFirst = "first"
+	nameStyleLast  = "last"
+)
+
+// firstNameData and surnameData are short, culturally varied name lists in plain ASCII,
+// for generating synthetic test personas.
+//
+//go:embed firstnames.txt
+var firstNameData string
+
+//go:embed surnames.txt
+var surnameData string
+
+var (
+	firstNames = strings.Fields(firstNameData)
+	surnames   = strings.Fields(surnameData)
+)
+
+// randomNameResponse holds the generated name in Value; First and Last hold the parts the
+// style asked for and are omitted otherwise.
+type randomNameResponse struct {
+	Value         string `json:"value"`
+	First         string `json:"first,omitempty"`
+	Last          string `json:"last,omitempty"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomNameArgs struct {
+	Style string `json:"style,omitempty"`
+}
+
+func randomNameHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomNameArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_name", err), nil
+	}
+
+	style := nameStyleFull
+	if args.Style != "" {
+		style = args.Style
+	}
+
+	first, last, err := randomName(ctx, style)
+	if err != nil {
+		return toolErrorResult("random_name", err), nil
+	}
+
+	value := strings.TrimSpace(first + " " + last)
+	response := randomNameResponse{Value: value, First: first, Last: last, SchemaVersion: schemaVersions["random_name"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomName returns a first name, a surname, or both, depending on style. The part the style
+// leaves out is returned empty.
+func randomName(ctx context.Context, style string) (string, string, error) {
+	var wantFirst, wantLast bool
+	switch style {
+	case nameStyleFull:
+		wantFirst, wantLast = true, true
+	case nameStyleFirst:
+		wantFirst = true
+	case nameStyleLast:
+		wantLast = true
+	default:
+		return "", "", fmt.Errorf("unsupported style %q: must be %s, %s, or %s", style, nameStyleFull, nameStyleFirst, nameStyleLast)
+	}
+
+	var first, last string
+	var err error
+	if wantFirst {
+		if first, err = randomListItem(ctx, firstNames); err != nil {
+			return "", "", err
+		}
+	}
+	if wantLast {
+		if last, err = randomListItem(ctx, surnames); err != nil {
+			return "", "", err
+		}
+	}
+	return first, last, nil
+}
+
+// randomListItem returns a uniformly chosen element of the non-empty list.
+func randomListItem(ctx context.Context, list []string) (string, error) {
+	index, err := sourceFromContext(ctx).Int64(0, int64(len(list)-1))
+	if err != nil {
+		return "", err
+	}
+	return list[index], nil
+}
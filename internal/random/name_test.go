@@ -0,0 +1,102 @@
+package random
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomNameHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		request   mcp.CallToolRequest
+		wantFirst bool
+		wantLast  bool
+		wantErr   bool
+	}{
+		{
+			desc:      "valid request with no args",
+			request:   mcp.CallToolRequest{},
+			wantFirst: true,
+			wantLast:  true,
+		},
+		{
+			desc:      "valid request for a full name",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"style": "full"}}},
+			wantFirst: true,
+			wantLast:  true,
+		},
+		{
+			desc:      "valid request for a first name",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"style": "first"}}},
+			wantFirst: true,
+		},
+		{
+			desc:     "valid request for a surname",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"style": "last"}}},
+			wantLast: true,
+		},
+		{
+			desc:    "invalid request with unknown style",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"style": "nickname"}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomNameHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomNameHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomNameHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomNameHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomNameHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomNameResponse)
+			if !ok {
+				t.Fatalf("randomNameHandler() structured content type = %T, want randomNameResponse", result.StructuredContent)
+			}
+			if got := structured.First != ""; got != tc.wantFirst || (got && !slices.Contains(firstNames, structured.First)) {
+				t.Fatalf("randomNameHandler() first = %q, want a first name: %v", structured.First, tc.wantFirst)
+			}
+			if got := structured.Last != ""; got != tc.wantLast || (got && !slices.Contains(surnames, structured.Last)) {
+				t.Fatalf("randomNameHandler() last = %q, want a surname: %v", structured.Last, tc.wantLast)
+			}
+			want := structured.First + structured.Last
+			if tc.wantFirst && tc.wantLast {
+				want = structured.First + " " + structured.Last
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if structured.Value != want || !ok || textContent.Text != want {
+				t.Fatalf("randomNameHandler() value = %q, text = %+v, want %q", structured.Value, result.Content[0], want)
+			}
+		})
+	}
+}
+
+func TestNameLists(t *testing.T) {
+	for name, list := range map[string][]string{"firstnames.txt": firstNames, "surnames.txt": surnames} {
+		if len(list) < 50 {
+			t.Fatalf("%s has %d names, want at least 50", name, len(list))
+		}
+		for _, entry := range list {
+			for _, r := range entry {
+				if r < 'A' || r > 'z' || (r > 'Z' && r < 'a') {
+					t.Fatalf("%s entry %q must be plain ASCII letters", name, entry)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomNormalIntResponse struct {
+	Value         int64   `json:"value"`
+	Mean          float64 `json:"mean"`
+	StdDev        float64 `json:"stddev"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomNormalIntArgs struct {
+	Mean   *float64 `json:"mean,omitempty"`
+	StdDev *float64 `json:"stddev,omitempty"`
+	Min    *int64   `json:"min,omitempty"`
+	Max    *int64   `json:"max,omitempty"`
+}
+
+func randomNormalIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomNormalIntArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_normal_int", err), nil
+	}
+
+	mean := 0.0
+	stddev := 1.0
+	if args.Mean != nil {
+		mean = *args.Mean
+	}
+	if args.StdDev != nil {
+		stddev = *args.StdDev
+	}
+
+	value, err := randomNormalInt(ctx, mean, stddev, args.Min, args.Max)
+	if err != nil {
+		return toolErrorResult("random_normal_int", err), nil
+	}
+
+	response := randomNormalIntResponse{Value: value, Mean: mean, StdDev: stddev, SchemaVersion: schemaVersions["random_normal_int"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(value, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomNormalInt draws a normal sample with the given mean and stddev and rounds it to the nearest int64.
+// When min or max is non-nil the result is clamped to that bound.
+func randomNormalInt(ctx context.Context, mean, stddev float64, min, max *int64) (int64, error) {
+	if min != nil && max != nil && *min > *max {
+		return 0, &RangeError{}
+	}
+
+	sample, err := randomGaussian(ctx, mean, stddev)
+	if err != nil {
+		return 0, err
+	}
+	rounded := math.Round(sample)
+
+	// Clamp before converting so out-of-range samples never reach the int64 conversion.
+	switch {
+	case min != nil && rounded < float64(*min):
+		return *min, nil
+	case max != nil && rounded > float64(*max):
+		return *max, nil
+	}
+	// float64(math.MaxInt64) rounds up to 2^63, so the upper check must be exclusive.
+	if rounded < math.MinInt64 || rounded >= math.MaxInt64 {
+		return 0, fmt.Errorf("sample %g overflows int64", rounded)
+	}
+
+	value := int64(rounded)
+	if min != nil && value < *min {
+		value = *min
+	}
+	if max != nil && value > *max {
+		value = *max
+	}
+	return value, nil
+}
@@ -0,0 +1,113 @@
+package random
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomNormalIntHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		mean    float64
+		stddev  float64
+		min     int64
+		max     int64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			mean:    0,
+			stddev:  1,
+			min:     -100,
+			max:     100,
+		},
+		{
+			desc:    "valid request with mean and stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 1000.0, "stddev": 10.0}}},
+			mean:    1000,
+			stddev:  10,
+			min:     900,
+			max:     1100,
+		},
+		{
+			desc:    "valid request clamped to min and max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 50.0, "stddev": 1000.0, "min": 0, "max": 100}}},
+			mean:    50,
+			stddev:  1000,
+			min:     0,
+			max:     100,
+		},
+		{
+			desc:    "valid request with mean far below min",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": -1e30, "stddev": 1.0, "min": 5}}},
+			mean:    -1e30,
+			stddev:  1,
+			min:     5,
+			max:     5,
+		},
+		{
+			desc:    "invalid request with zero stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"stddev": 0.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with min greater than max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": 10, "max": 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request overflowing int64 without clamp",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 1e30, "stddev": 1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomNormalIntHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomNormalIntHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomNormalIntHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomNormalIntHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomNormalIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomNormalIntHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			valueFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+			if err != nil {
+				t.Fatalf("randomNormalIntHandler() invalid text content: %v", err)
+			}
+
+			structured, ok := result.StructuredContent.(randomNormalIntResponse)
+			if !ok {
+				t.Fatalf("randomNormalIntHandler() structured content type = %T, want randomNormalIntResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomNormalIntHandler() structured value = %d, text value = %d", structured.Value, valueFromText)
+			}
+			if structured.Mean != tc.mean || structured.StdDev != tc.stddev {
+				t.Fatalf("randomNormalIntHandler() mean/stddev = %g/%g, want %g/%g", structured.Mean, structured.StdDev, tc.mean, tc.stddev)
+			}
+			if structured.Value < tc.min || structured.Value > tc.max {
+				t.Fatalf("randomNormalIntHandler() value = %d, want within [%d, %d]", structured.Value, tc.min, tc.max)
+			}
+		})
+	}
+}
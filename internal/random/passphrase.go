@@ -0,0 +1,59 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxPassphraseWords caps the number of words random_passphrase will join.
+const maxPassphraseWords = 64
+
+// defaultPassphraseSeparator joins passphrase words when no separator is given.
+const defaultPassphraseSeparator = "-"
+
+type randomPassphraseResponse struct {
+	Value         string   `json:"value"`
+	Words         []string `json:"words"`
+	Entropy       float64  `json:"entropy"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Algorithm     string   `json:"algorithm"`
+}
+
+type randomPassphraseArgs struct {
+	Words     int     `json:"words"`
+	Separator *string `json:"separator,omitempty"`
+}
+
+func randomPassphraseHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomPassphraseArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_passphrase", err), nil
+	}
+
+	if args.Words <= 0 || args.Words > maxPassphraseWords {
+		return toolErrorResult("random_passphrase", fmt.Errorf("words must be between 1 and %d", maxPassphraseWords)), nil
+	}
+	separator := defaultPassphraseSeparator
+	if args.Separator != nil {
+		separator = *args.Separator
+	}
+
+	words, err := randomWords(ctx, args.Words)
+	if err != nil {
+		return toolErrorResult("random_passphrase", err), nil
+	}
+	value := strings.Join(words, separator)
+	slog.InfoContext(ctx, "randomPassphraseHandler", slog.Int("words", args.Words), resultAttr("random_passphrase", value))
+
+	response := randomPassphraseResponse{Value: value, Words: words, Entropy: entropyBits(args.Words, len(wordList)), SchemaVersion: schemaVersions["random_passphrase"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
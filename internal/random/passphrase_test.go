@@ -0,0 +1,94 @@
+package random
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomPassphraseHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		request   mcp.CallToolRequest
+		words     int
+		separator string
+		wantErr   bool
+	}{
+		{
+			desc:      "valid request with default separator",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 6}}},
+			words:     6,
+			separator: "-",
+		},
+		{
+			desc:      "valid request with custom separator",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 4, "separator": " "}}},
+			words:     4,
+			separator: " ",
+		},
+		{
+			desc:      "valid request with empty separator",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": 3, "separator": ""}}},
+			words:     3,
+			separator: "",
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with words over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"words": maxPassphraseWords + 1}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomPassphraseHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomPassphraseHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomPassphraseHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomPassphraseHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomPassphraseHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomPassphraseHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			structured, ok := result.StructuredContent.(randomPassphraseResponse)
+			if !ok {
+				t.Fatalf("randomPassphraseHandler() structured content type = %T, want randomPassphraseResponse", result.StructuredContent)
+			}
+			if len(structured.Words) != tc.words {
+				t.Fatalf("randomPassphraseHandler() returned %d words, want %d", len(structured.Words), tc.words)
+			}
+			if structured.Value != textContent.Text || structured.Value != strings.Join(structured.Words, tc.separator) {
+				t.Fatalf("randomPassphraseHandler() value %q does not join words %v with %q", structured.Value, structured.Words, tc.separator)
+			}
+			for _, word := range structured.Words {
+				if !slices.Contains(wordList, word) {
+					t.Fatalf("randomPassphraseHandler() word %q is not in the word list", word)
+				}
+			}
+			if want := float64(tc.words) * math.Log2(7776); math.Abs(structured.Entropy-want) > 1e-9 {
+				t.Fatalf("randomPassphraseHandler() entropy = %f, want %f", structured.Entropy, want)
+			}
+		})
+	}
+}
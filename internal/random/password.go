@@ -0,0 +1,118 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxPasswordLength caps the length of passwords generated by random_password.
+const maxPasswordLength = 1024
+
+const (
+	passwordUpper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordLower  = "abcdefghijklmnopqrstuvwxyz"
+	passwordDigit  = "0123456789"
+	passwordSymbol = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+)
+
+// randomPasswordResponse reports EntropyBits as length * log2(size of the enabled classes'
+// union). Guaranteeing one character per class makes the true entropy slightly lower.
+type randomPasswordResponse struct {
+	Value         string  `json:"value"`
+	EntropyBits   float64 `json:"entropyBits"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+// randomPasswordArgs enables each character class by default; callers opt out by
+// setting the corresponding flag to false.
+type randomPasswordArgs struct {
+	Length        int   `json:"length"`
+	RequireUpper  *bool `json:"requireUpper,omitempty"`
+	RequireLower  *bool `json:"requireLower,omitempty"`
+	RequireDigit  *bool `json:"requireDigit,omitempty"`
+	RequireSymbol *bool `json:"requireSymbol,omitempty"`
+}
+
+func randomPasswordHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomPasswordArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_password", err), nil
+	}
+
+	var classes []string
+	unionSize := 0
+	for _, class := range []struct {
+		required *bool
+		chars    string
+	}{
+		{args.RequireUpper, passwordUpper},
+		{args.RequireLower, passwordLower},
+		{args.RequireDigit, passwordDigit},
+		{args.RequireSymbol, passwordSymbol},
+	} {
+		if class.required == nil || *class.required {
+			classes = append(classes, class.chars)
+			unionSize += len(class.chars)
+		}
+	}
+
+	value, err := randomPassword(ctx, args.Length, classes)
+	if err != nil {
+		return toolErrorResult("random_password", err), nil
+	}
+	slog.InfoContext(ctx, "randomPasswordHandler", slog.Int("length", args.Length), slog.Int("classes", len(classes)), resultAttr("random_password", value))
+
+	response := randomPasswordResponse{Value: value, EntropyBits: entropyBits(args.Length, unionSize), SchemaVersion: schemaVersions["random_password"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomPassword returns a password of the given length containing at least one character
+// from each class. The remaining characters are drawn from the union of all classes and the
+// result is shuffled so the guaranteed characters do not cluster at the front.
+func randomPassword(ctx context.Context, length int, classes []string) (string, error) {
+	if length <= 0 {
+		return "", &ZeroLengthError{}
+	}
+	if length > maxPasswordLength {
+		return "", fmt.Errorf("length cannot exceed %d", maxPasswordLength)
+	}
+	if len(classes) == 0 {
+		return "", fmt.Errorf("at least one character class must be enabled")
+	}
+	if length < len(classes) {
+		return "", fmt.Errorf("length %d is too short to include %d required character classes", length, len(classes))
+	}
+
+	var union string
+	password := make([]byte, 0, length)
+	for _, class := range classes {
+		union += class
+		index, err := randomIndex(ctx, len(class))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, class[index])
+	}
+	for len(password) < length {
+		index, err := randomIndex(ctx, len(union))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, union[index])
+	}
+
+	shuffled, err := shuffledCopy(ctx, password)
+	if err != nil {
+		return "", err
+	}
+	return string(shuffled), nil
+}
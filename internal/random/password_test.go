@@ -0,0 +1,113 @@
+package random
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomPasswordHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		length  int
+		classes []string
+		wantErr bool
+	}{
+		{
+			desc:    "invalid request with zero length",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with length over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": maxPasswordLength + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with length shorter than required classes",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 3}}},
+			wantErr: true,
+		},
+		{
+			desc: "invalid request with every class disabled",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"length": 8, "requireUpper": false, "requireLower": false, "requireDigit": false, "requireSymbol": false,
+			}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with default classes",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4}}},
+			length:  4,
+			classes: []string{passwordUpper, passwordLower, passwordDigit, passwordSymbol},
+		},
+		{
+			desc: "valid request with symbols disabled",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"length": 16, "requireSymbol": false,
+			}}},
+			length:  16,
+			classes: []string{passwordUpper, passwordLower, passwordDigit},
+		},
+		{
+			desc: "valid request with digits only",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"length": 6, "requireUpper": false, "requireLower": false, "requireSymbol": false,
+			}}},
+			length:  6,
+			classes: []string{passwordDigit},
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomPasswordHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomPasswordHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomPasswordHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomPasswordHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomPasswordHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomPasswordHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			if len(textContent.Text) != tc.length {
+				t.Fatalf("randomPasswordHandler() text length = %d, want %d", len(textContent.Text), tc.length)
+			}
+
+			union := strings.Join(tc.classes, "")
+			for _, class := range tc.classes {
+				if !strings.ContainsAny(textContent.Text, class) {
+					t.Fatalf("randomPasswordHandler() password missing a character from %q", class)
+				}
+			}
+			for _, r := range textContent.Text {
+				if !strings.ContainsRune(union, r) {
+					t.Fatalf("randomPasswordHandler() rune %q not in enabled classes", r)
+				}
+			}
+
+			structured, ok := result.StructuredContent.(randomPasswordResponse)
+			if !ok {
+				t.Fatalf("randomPasswordHandler() structured content type = %T, want randomPasswordResponse", result.StructuredContent)
+			}
+			if structured.Value != textContent.Text {
+				t.Fatalf("randomPasswordHandler() structured value != text value")
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxPermutationSize caps n for random_permutation to bound memory and work.
+const maxPermutationSize = 100000
+
+type randomPermutationResponse struct {
+	Values        []int64 `json:"values"`
+	N             int     `json:"n"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomPermutationArgs struct {
+	N int `json:"n"`
+}
+
+func randomPermutationHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomPermutationArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_permutation", err), nil
+	}
+
+	values, err := randomPermutation(ctx, args.N)
+	if err != nil {
+		return toolErrorResult("random_permutation", err), nil
+	}
+
+	lines := make([]string, len(values))
+	for i, value := range values {
+		lines[i] = strconv.FormatInt(value, 10)
+	}
+
+	response := randomPermutationResponse{Values: values, N: args.N, SchemaVersion: schemaVersions["random_permutation"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomPermutation returns the integers 0 through n-1 in a uniformly random order.
+// N must be greater than zero and no more than maxPermutationSize.
+func randomPermutation(ctx context.Context, n int) ([]int64, error) {
+	if n <= 0 || n > maxPermutationSize {
+		return nil, fmt.Errorf("n must be between 1 and %d", maxPermutationSize)
+	}
+
+	identity := make([]int64, n)
+	for i := range identity {
+		identity[i] = int64(i)
+	}
+	return shuffledCopy(ctx, identity)
+}
@@ -0,0 +1,117 @@
+package random
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomPermutationHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		n       int
+		wantErr bool
+	}{
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative n",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": -3}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with n over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": maxPermutationSize + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with n of one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 1}}},
+			n:       1,
+		},
+		{
+			desc:    "valid request with n of 52",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"n": 52}}},
+			n:       52,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomPermutationHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomPermutationHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomPermutationHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomPermutationHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomPermutationHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomPermutationHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			structured, ok := result.StructuredContent.(randomPermutationResponse)
+			if !ok {
+				t.Fatalf("randomPermutationHandler() structured content type = %T, want randomPermutationResponse", result.StructuredContent)
+			}
+			if structured.N != tc.n || len(structured.Values) != tc.n {
+				t.Fatalf("randomPermutationHandler() n = %d with %d values, want %d", structured.N, len(structured.Values), tc.n)
+			}
+
+			lines := strings.Split(textContent.Text, "\n")
+			for i, line := range lines {
+				value, err := strconv.ParseInt(line, 10, 64)
+				if err != nil || value != structured.Values[i] {
+					t.Fatalf("randomPermutationHandler() text line %d = %q, want %d", i, line, structured.Values[i])
+				}
+			}
+
+			sorted := slices.Clone(structured.Values)
+			slices.Sort(sorted)
+			for i, value := range sorted {
+				if value != int64(i) {
+					t.Fatalf("randomPermutationHandler() values %v are not a permutation of 0..%d", structured.Values, tc.n-1)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomPermutationIsUniform(t *testing.T) {
+	const trials = 6000
+	counts := map[string]int{}
+	for range trials {
+		values, err := randomPermutation(t.Context(), 3)
+		if err != nil {
+			t.Fatalf("randomPermutation() error = %v", err)
+		}
+		counts[fmt.Sprint(values)]++
+	}
+	if len(counts) != 6 {
+		t.Fatalf("randomPermutation(3) produced %d distinct orderings, want 6: %v", len(counts), counts)
+	}
+	// Each ordering is expected 1000 times; allow a wide margin to keep the test stable.
+	for ordering, count := range counts {
+		if count < 800 || count > 1200 {
+			t.Fatalf("randomPermutation(3) ordering %s occurred %d times, want about %d", ordering, count, trials/6)
+		}
+	}
+}
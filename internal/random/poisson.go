@@ -0,0 +1,85 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// poissonNormalThreshold is the lambda above which random_poisson switches from Knuth's
+	// algorithm, whose cost grows linearly with lambda, to a normal approximation.
+	poissonNormalThreshold = 30
+	// maxPoissonLambda keeps normal-approximation samples well inside the int64 range.
+	maxPoissonLambda = 1e12
+)
+
+type randomPoissonResponse struct {
+	Value         int64   `json:"value"`
+	Lambda        float64 `json:"lambda"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomPoissonArgs struct {
+	Lambda float64 `json:"lambda"`
+}
+
+func randomPoissonHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomPoissonArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_poisson", err), nil
+	}
+
+	value, err := randomPoisson(ctx, args.Lambda)
+	if err != nil {
+		return toolErrorResult("random_poisson", err), nil
+	}
+
+	response := randomPoissonResponse{Value: value, Lambda: args.Lambda, SchemaVersion: schemaVersions["random_poisson"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(value, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomPoisson returns a sample from the Poisson distribution with mean lambda. Lambda must be
+// finite, greater than zero, and no more than maxPoissonLambda. Small means use Knuth's
+// multiplication algorithm; means above poissonNormalThreshold use a normal approximation
+// rounded to the nearest non-negative integer.
+func randomPoisson(ctx context.Context, lambda float64) (int64, error) {
+	if math.IsNaN(lambda) || math.IsInf(lambda, 0) {
+		return 0, fmt.Errorf("lambda must be finite")
+	}
+	if lambda <= 0 || lambda > maxPoissonLambda {
+		return 0, fmt.Errorf("lambda must be greater than zero and no more than %g", float64(maxPoissonLambda))
+	}
+
+	if lambda > poissonNormalThreshold {
+		z, _, err := standardNormalPair(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return int64(math.Max(0, math.Round(lambda+math.Sqrt(lambda)*z))), nil
+	}
+
+	limit := math.Exp(-lambda)
+	product := 1.0
+	var k int64
+	for {
+		unit, err := sourceFromContext(ctx).UnitFloat64()
+		if err != nil {
+			return 0, err
+		}
+		product *= unit
+		if product <= limit {
+			return k, nil
+		}
+		k++
+	}
+}
@@ -0,0 +1,111 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomPoissonHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		lambda  float64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with small lambda",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"lambda": 3.5}}},
+			lambda:  3.5,
+		},
+		{
+			desc:    "valid request with large lambda",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"lambda": 1000.0}}},
+			lambda:  1000,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative lambda",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"lambda": -1.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with lambda over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"lambda": maxPoissonLambda * 2}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomPoissonHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomPoissonHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomPoissonHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomPoissonHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomPoissonHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomPoissonHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			valueFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+			if err != nil {
+				t.Fatalf("randomPoissonHandler() invalid text content: %v", err)
+			}
+
+			structured, ok := result.StructuredContent.(randomPoissonResponse)
+			if !ok {
+				t.Fatalf("randomPoissonHandler() structured content type = %T, want randomPoissonResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText || structured.Value < 0 {
+				t.Fatalf("randomPoissonHandler() structured value = %d, text value = %d", structured.Value, valueFromText)
+			}
+			if structured.Lambda != tc.lambda {
+				t.Fatalf("randomPoissonHandler() lambda = %g, want %g", structured.Lambda, tc.lambda)
+			}
+		})
+	}
+}
+
+func TestRandomPoissonMoments(t *testing.T) {
+	const samples = 20000
+	for _, lambda := range []float64{0.5, 4, 25, 100} {
+		var sum, sumSquares float64
+		for i := 0; i < samples; i++ {
+			value, err := randomPoisson(t.Context(), lambda)
+			if err != nil {
+				t.Fatalf("randomPoisson(%g) error = %v", lambda, err)
+			}
+			sum += float64(value)
+			sumSquares += float64(value) * float64(value)
+		}
+
+		// Mean and variance both equal lambda; allow about six standard errors.
+		mean := sum / samples
+		variance := sumSquares/samples - mean*mean
+		if tolerance := 6 * math.Sqrt(lambda/samples); math.Abs(mean-lambda) > tolerance {
+			t.Fatalf("randomPoisson(%g) sample mean = %f, want within %f of %g", lambda, mean, tolerance, lambda)
+		}
+		if math.Abs(variance-lambda) > 0.1*lambda+0.05 {
+			t.Fatalf("randomPoisson(%g) sample variance = %f, want about %g", lambda, variance, lambda)
+		}
+	}
+}
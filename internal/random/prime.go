@@ -0,0 +1,61 @@
+package random
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	minPrimeBits = 2
+	// maxPrimeBits bounds random_prime so a single call cannot run for an unreasonable time.
+	maxPrimeBits = 4096
+)
+
+type randomPrimeResponse struct {
+	Value         string `json:"value"`
+	Bits          int    `json:"bits"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomPrimeArgs struct {
+	Bits int `json:"bits"`
+}
+
+func randomPrimeHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomPrimeArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_prime", err), nil
+	}
+
+	value, err := randomPrime(ctx, args.Bits)
+	if err != nil {
+		return toolErrorResult("random_prime", err), nil
+	}
+
+	response := randomPrimeResponse{Value: value, Bits: args.Bits, SchemaVersion: schemaVersions["random_prime"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomPrime returns a random prime of exactly the given bit length as a decimal string.
+// It relies on crypto/rand.Prime, which uses Go's probabilistic Miller-Rabin and
+// Baillie-PSW tests; the result is prime with overwhelming but not absolute certainty.
+func randomPrime(ctx context.Context, bits int) (string, error) {
+	if bits < minPrimeBits || bits > maxPrimeBits {
+		return "", fmt.Errorf("bits must be between %d and %d", minPrimeBits, maxPrimeBits)
+	}
+
+	prime, err := rand.Prime(sourceFromContext(ctx), bits)
+	if err != nil {
+		return "", err
+	}
+	return prime.String(), nil
+}
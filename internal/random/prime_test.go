@@ -0,0 +1,118 @@
+package random
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomPrimeHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		bits    int
+		wantErr bool
+	}{
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with one bit",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bits": 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with bits over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bits": maxPrimeBits + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with two bits",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bits": 2}}},
+			bits:    2,
+		},
+		{
+			desc:    "valid request with eight bits",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bits": 8}}},
+			bits:    8,
+		},
+		{
+			desc:    "valid request with 128 bits",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bits": 128}}},
+			bits:    128,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomPrimeHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomPrimeHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomPrimeHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomPrimeHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomPrimeHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomPrimeHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			prime, ok := new(big.Int).SetString(textContent.Text, 10)
+			if !ok {
+				t.Fatalf("randomPrimeHandler() invalid decimal text %q", textContent.Text)
+			}
+			if prime.BitLen() != tc.bits {
+				t.Fatalf("randomPrimeHandler() bit length = %d, want %d", prime.BitLen(), tc.bits)
+			}
+
+			structured, ok := result.StructuredContent.(randomPrimeResponse)
+			if !ok {
+				t.Fatalf("randomPrimeHandler() structured content type = %T, want randomPrimeResponse", result.StructuredContent)
+			}
+			if structured.Value != textContent.Text || structured.Bits != tc.bits {
+				t.Fatalf("randomPrimeHandler() structured = %+v, want value %s with %d bits", structured, textContent.Text, tc.bits)
+			}
+		})
+	}
+}
+
+func TestRandomPrimeIsPrime(t *testing.T) {
+	for bits := minPrimeBits; bits <= 16; bits++ {
+		value, err := randomPrime(t.Context(), bits)
+		if err != nil {
+			t.Fatalf("randomPrime(%d) error = %v", bits, err)
+		}
+		n, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			t.Fatalf("randomPrime(%d) = %q, not a decimal integer", bits, value)
+		}
+		if !isPrimeByTrialDivision(n.Int64()) {
+			t.Fatalf("randomPrime(%d) = %d, which is not prime", bits, n.Int64())
+		}
+	}
+}
+
+func isPrimeByTrialDivision(n int64) bool {
+	if n < 2 {
+		return false
+	}
+	for d := int64(2); d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
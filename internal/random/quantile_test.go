@@ -0,0 +1,131 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestNormalQuantileHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		want    float64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request for the median",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.5}}},
+			want:    0,
+		},
+		{
+			desc:    "valid request for the 95th percentile",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.95}}},
+			want:    1.6448536269514722,
+		},
+		{
+			desc:    "valid request with mean and stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.975, "mean": 100.0, "stddev": 15.0}}},
+			want:    100 + 15*1.959963984540054,
+		},
+		{
+			desc:    "valid request in the lower tail",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.001}}},
+			want:    -3.090232306167813,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with probability one",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 1.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative probability",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": -0.5}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with quantile overflowing in the tail",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 1e-300, "stddev": 1.5e307}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"probability": 0.5, "stddev": 0.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := normalQuantileHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("normalQuantileHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("normalQuantileHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("normalQuantileHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("normalQuantileHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(normalQuantileResponse)
+			if !ok {
+				t.Fatalf("normalQuantileHandler() structured content type = %T, want normalQuantileResponse", result.StructuredContent)
+			}
+			if math.Abs(structured.Value-tc.want) > 1e-9*math.Max(1, math.Abs(tc.want)) {
+				t.Fatalf("normalQuantileHandler() value = %.15g, want %.15g", structured.Value, tc.want)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != fmt.Sprintf("%g", structured.Value) {
+				t.Fatalf("normalQuantileHandler() text content = %+v, want %g", result.Content[0], structured.Value)
+			}
+		})
+	}
+}
+
+func TestStandardNormalQuantile(t *testing.T) {
+	// Reference values from Wichura's AS241 as implemented by Python's statistics.NormalDist.
+	// They cover both tails, both sides of the tail boundaries, and the centre.
+	testCases := []struct {
+		p    float64
+		want float64
+	}{
+		{p: 1e-300, want: -37.0470962993612},
+		{p: 1e-12, want: -7.034483825301132},
+		{p: 1e-6, want: -4.753424308822899},
+		{p: 0.01, want: -2.3263478740408408},
+		{p: 0.02424, want: -1.973136611944544},
+		{p: 0.02426, want: -1.9727855514678603},
+		{p: 0.1, want: -1.2815515655446008},
+		{p: 0.3, want: -0.5244005127080407},
+		{p: 0.5, want: 0.0},
+		{p: 0.7, want: 0.5244005127080407},
+		{p: 0.9, want: 1.2815515655446008},
+		{p: 0.97574, want: 1.9727855514678616},
+		{p: 0.97576, want: 1.9731366119445435},
+		{p: 0.99, want: 2.3263478740408408},
+		{p: 1 - 1e-6, want: 4.753424308817089},
+		{p: 1 - 1e-12, want: 7.0344869100478356},
+	}
+
+	for _, tc := range testCases {
+		got := standardNormalQuantile(tc.p)
+		if math.Abs(got-tc.want) > 1e-12*math.Max(1, math.Abs(tc.want)) {
+			t.Errorf("standardNormalQuantile(%g) = %.17g, want %.17g", tc.p, got, tc.want)
+		}
+	}
+}
@@ -3,29 +3,105 @@ package random
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// algorithmCryptoRand identifies values drawn from crypto/rand in structured responses.
+const algorithmCryptoRand = "crypto/rand"
+
+// Units reported alongside scalar durations so clients do not have to guess the scale.
+const (
+	unitNanoseconds  = "nanoseconds"
+	unitMilliseconds = "milliseconds"
+)
+
+// random_ascii draws from the printable characters [asciiPrintableMin, asciiPrintableMax] unless
+// the caller narrows the range; any range within [0, maxASCIICode] is accepted.
+const (
+	asciiPrintableMin = 32
+	asciiPrintableMax = 126
+	maxASCIICode      = 127
+)
+
+// maxIntCount caps the number of integers random_int will generate in a single call.
+const maxIntCount = 10000
+
+const (
+	// minIntBase and maxIntBase bound the radix random_int accepts for its text output,
+	// matching the bases strconv.FormatInt supports.
+	minIntBase = 2
+	maxIntBase = 36
+)
+
+const (
+	// maxExclusionAttempts bounds rejection sampling when random_int has an exclusion list.
+	maxExclusionAttempts = 100
+	// maxExplicitCandidates is the largest range random_int will enumerate once rejection sampling gives up.
+	maxExplicitCandidates = 1 << 16
+)
+
+// randomIntResponse holds a single draw in Value. When more than one value is
+// requested, Values holds every draw and Value mirrors the first one.
+// EffectiveMin and EffectiveMax are the inclusive bounds drawn from after
+// includeMin and includeMax are applied.
+//
+// Probability is each value's selection probability: 1 over the number of values the
+// draw could have produced, after exclusivity, step, and exclude are applied. It is
+// rounded to the nearest float64, so for the largest ranges it is approximate but
+// never 0.
 type randomIntResponse struct {
-	Value int64 `json:"value"`
+	Value         int64   `json:"value"`
+	Values        []int64 `json:"values,omitempty"`
+	EffectiveMin  int64   `json:"effectiveMin"`
+	EffectiveMax  int64   `json:"effectiveMax"`
+	Step          int64   `json:"step"`
+	Exclude       []int64 `json:"exclude,omitempty"`
+	Probability   float64 `json:"probability"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
 }
 
+// randomIntArgs accepts the bounds as decimal strings too, since JSON numbers above 2^53 may
+// be rounded by the client or the decoder before they reach min and max. MinString and
+// MaxString take precedence over Min and Max when both are given.
 type randomIntArgs struct {
-	Min        *int64 `json:"min,omitempty"`
-	Max        *int64 `json:"max,omitempty"`
-	IncludeMin *bool  `json:"includeMin,omitempty"`
-	IncludeMax *bool  `json:"includeMax,omitempty"`
+	Min        *int64  `json:"min,omitempty"`
+	Max        *int64  `json:"max,omitempty"`
+	MinString  *string `json:"minString,omitempty"`
+	MaxString  *string `json:"maxString,omitempty"`
+	IncludeMin *bool   `json:"includeMin,omitempty"`
+	IncludeMax *bool   `json:"includeMax,omitempty"`
+	Count      *int    `json:"count,omitempty"`
+	Step       *int64  `json:"step,omitempty"`
+	Exclude    []int64 `json:"exclude,omitempty"`
+	Base       *int    `json:"base,omitempty"`
 }
 
+// maxFloatDecimals is the largest number of decimal places random_float will round to.
+const maxFloatDecimals = 15
+
+// randomFloatResponse reports which bounds the caller provided and the inclusive range the
+// value was drawn from, after defaults and exclusivity (a one-ulp Nextafter step) were applied.
+// LogUniform reports whether the value was drawn uniformly in log space.
 type randomFloatResponse struct {
-	Value float64 `json:"value"`
+	Value         float64 `json:"value"`
+	Decimals      *int    `json:"decimals,omitempty"`
+	LogUniform    bool    `json:"logUniform"`
+	MinProvided   bool    `json:"minProvided"`
+	MaxProvided   bool    `json:"maxProvided"`
+	EffectiveMin  float64 `json:"effectiveMin"`
+	EffectiveMax  float64 `json:"effectiveMax"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
 }
 
 type randomFloatArgs struct {
@@ -33,72 +109,567 @@ type randomFloatArgs struct {
 	Max        *float64 `json:"max,omitempty"`
 	IncludeMin *bool    `json:"includeMin,omitempty"`
 	IncludeMax *bool    `json:"includeMax,omitempty"`
+	Decimals   *int     `json:"decimals,omitempty"`
+	LogUniform bool     `json:"logUniform,omitempty"`
 }
 
+// randomASCIIResponse reports the effective character code range in Min and Max.
 type randomASCIIResponse struct {
-	Value string `json:"value"`
+	Value         string  `json:"value"`
+	Min           int     `json:"min"`
+	Max           int     `json:"max"`
+	EntropyBits   float64 `json:"entropyBits"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
 }
 
 type randomASCIIArgs struct {
-	Length int `json:"length"`
+	Length int  `json:"length"`
+	Min    *int `json:"min,omitempty"`
+	Max    *int `json:"max,omitempty"`
 }
 
+// randomStringResponse reports the preset name in Charset when a preset was used,
+// otherwise the custom charset supplied by the caller.
 type randomStringResponse struct {
-	Value string `json:"value"`
+	Value         string  `json:"value"`
+	Charset       string  `json:"charset"`
+	EntropyBits   float64 `json:"entropyBits"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
 }
 
 type randomStringArgs struct {
 	Length  int    `json:"length"`
-	Charset string `json:"charset"`
+	Charset string `json:"charset,omitempty"`
+	Preset  string `json:"preset,omitempty"`
+}
+
+// charsetPresets maps the named presets accepted by random_string to their characters.
+var charsetPresets = map[string]string{
+	"alphanumeric": "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	"alpha":        "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	"numeric":      "0123456789",
+	"hex":          "0123456789abcdef",
+	"lowercase":    "abcdefghijklmnopqrstuvwxyz",
+	"uppercase":    "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
 }
 
 // NewMCPServer builds the MCP server with the random_int tool registered.
-func NewMCPServer(name, version string) *server.MCPServer {
+func NewMCPServer(name, version string, opts ...Option) *server.MCPServer {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	mcpServer := server.NewMCPServer(
 		name,
 		version,
 		server.WithInstructions("Use the random_int tool to get a cryptographically secure random integer."),
+		server.WithToolHandlerMiddleware(configMiddleware(cfg)),
+		server.WithToolHandlerMiddleware(requestIDMiddleware),
+		server.WithToolHandlerMiddleware(auditMiddleware),
+		server.WithToolHandlerMiddleware(timeoutMiddleware(cfg.handlerTimeout)),
+		// Inside the timeout, so a heavy slot and the in-flight count stay taken until the
+		// handler returns even if the timeout has already answered the caller.
+		server.WithToolHandlerMiddleware(heavyMiddleware(cfg.maxConcurrentHeavy)),
+		server.WithToolHandlerMiddleware(inFlightMiddleware(cfg.inFlight)),
 	)
+	addTool := func(tool mcp.Tool, handler server.ToolHandlerFunc) {
+		if cfg.toolEnabled(tool.Name) {
+			mcpServer.AddTool(withAuditSchema(tool), handler)
+		}
+	}
 
 	tool := mcp.NewTool(
 		"random_int",
-		mcp.WithDescription("Returns a cryptographically secure random integer. Optional arguments: min, max, includeMin, includeMax."),
+		mcp.WithDescription("Returns a cryptographically secure random integer. Optional arguments: min, max, minString and maxString (the bounds as decimal strings, for values beyond 2^53 that JSON numbers would round; they take precedence over min and max), includeMin, includeMax, count (number of values to return, default 1), step (only return multiples of step), exclude (values that must not be returned), base (radix for the text output, 2-36, default 10; the structured value stays decimal)."),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithInputSchema[randomIntArgs](),
 		mcp.WithOutputSchema[randomIntResponse](),
 	)
 
-	mcpServer.AddTool(tool, randomIntHandler)
+	addTool(tool, randomIntHandler)
 
 	floatTool := mcp.NewTool(
 		"random_float",
-		mcp.WithDescription("Returns a cryptographically secure random floating-point number. Optional arguments: min, max, includeMin, includeMax."),
+		mcp.WithDescription("Returns a cryptographically secure random floating-point number. Optional arguments: min (default 0), max (default the largest float64), includeMin, includeMax (both default true), decimals (round the result to 0-15 decimal places; by default the full float64 precision is returned), logUniform (sample uniformly in log space, so each order of magnitude between min and max is equally likely; requires min > 0 and max > min)."),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithInputSchema[randomFloatArgs](),
 		mcp.WithOutputSchema[randomFloatResponse](),
 	)
 
-	mcpServer.AddTool(floatTool, randomFloatHandler)
+	addTool(floatTool, randomFloatHandler)
 
 	stringTool := mcp.NewTool(
 		"random_ascii",
-		mcp.WithDescription("Returns a cryptographically secure random ASCII string. Required argument: length."),
+		mcp.WithDescription("Returns a cryptographically secure random ASCII string. Required argument: length. Optional arguments: min, max (inclusive character code range within 0-127, default 32-126, the printable characters; for example 65-90 for uppercase letters)."),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithInputSchema[randomASCIIArgs](),
 		mcp.WithOutputSchema[randomASCIIResponse](),
 	)
 
-	mcpServer.AddTool(stringTool, randomASCIIHandler)
+	addTool(stringTool, randomASCIIHandler)
 
 	charsetTool := mcp.NewTool(
 		"random_string",
-		mcp.WithDescription("Returns a cryptographically secure random string using a specific character set. Required arguments: length, charset."),
+		mcp.WithDescription("Returns a cryptographically secure random string using a specific character set. Required arguments: length and one of charset (custom characters) or preset (alphanumeric, alpha, numeric, hex, lowercase, uppercase)."),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithInputSchema[randomStringArgs](),
 		mcp.WithOutputSchema[randomStringResponse](),
 	)
 
-	mcpServer.AddTool(charsetTool, randomStringHandler)
+	addTool(charsetTool, randomStringHandler)
+
+	bytesTool := mcp.NewTool(
+		"random_bytes",
+		mcp.WithDescription("Returns cryptographically secure random bytes encoded as hex or base64. Required argument: length. Optional argument: encoding (hex or base64, default hex)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomBytesArgs](),
+		mcp.WithOutputSchema[randomBytesResponse](),
+	)
+
+	addTool(bytesTool, randomBytesHandler)
+
+	boolTool := mcp.NewTool(
+		"random_bool",
+		mcp.WithDescription("Returns a cryptographically secure random boolean. Optional argument: probability (chance of true in [0, 1], default 0.5)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomBoolArgs](),
+		mcp.WithOutputSchema[randomBoolResponse](),
+	)
+
+	addTool(boolTool, randomBoolHandler)
+
+	choiceTool := mcp.NewTool(
+		"random_choice",
+		mcp.WithDescription("Returns one item chosen at random from a list using a cryptographically secure source. Required argument: items. Optional arguments: weights (one non-negative weight per item for proportional selection), exclude (items to leave out, e.g. ones already returned, to avoid repeats across calls)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomChoiceArgs](),
+		mcp.WithOutputSchema[randomChoiceResponse](),
+	)
+
+	addTool(choiceTool, randomChoiceHandler)
+
+	sampleTool := mcp.NewTool(
+		"random_sample",
+		mcp.WithDescription("Returns k items drawn at random from a list using a cryptographically secure source. By default items are drawn without replacement, so they are distinct and k cannot exceed the list length. Required arguments: items, k. Optional argument: replace (draw each item independently, allowing duplicates and k larger than the list, for bootstrap resampling; default false)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomSampleArgs](),
+		mcp.WithOutputSchema[randomSampleResponse](),
+	)
+
+	addTool(sampleTool, randomSampleHandler)
+
+	shuffleTool := mcp.NewTool(
+		"random_shuffle",
+		mcp.WithDescription("Returns a cryptographically secure random permutation of a list. Required argument: items."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomShuffleArgs](),
+		mcp.WithOutputSchema[randomShuffleResponse](),
+	)
+
+	addTool(shuffleTool, randomShuffleHandler)
+
+	gaussianTool := mcp.NewTool(
+		"random_gaussian",
+		mcp.WithDescription("Returns a sample from a normal distribution using a cryptographically secure source. Optional arguments: mean (default 0), stddev (default 1), count (number of samples to return, default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomGaussianArgs](),
+		mcp.WithOutputSchema[randomGaussianResponse](),
+	)
+
+	addTool(gaussianTool, randomGaussianHandler)
+
+	exponentialTool := mcp.NewTool(
+		"random_exponential",
+		mcp.WithDescription("Returns a sample from an exponential distribution using a cryptographically secure source. Optional argument: rate (lambda, default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomExponentialArgs](),
+		mcp.WithOutputSchema[randomExponentialResponse](),
+	)
+
+	addTool(exponentialTool, randomExponentialHandler)
+
+	passwordTool := mcp.NewTool(
+		"random_password",
+		mcp.WithDescription("Returns a cryptographically secure random password containing at least one character from each enabled class. Required argument: length. Optional arguments: requireUpper, requireLower, requireDigit, requireSymbol (each default true)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomPasswordArgs](),
+		mcp.WithOutputSchema[randomPasswordResponse](),
+	)
+
+	addTool(passwordTool, randomPasswordHandler)
+
+	diceTool := mcp.NewTool(
+		"random_dice",
+		mcp.WithDescription("Rolls dice described by standard notation such as 3d6, 1d20+5, or 2d10-1 using a cryptographically secure source. Required argument: notation. Optional advantage or disadvantage rolls a single die twice and keeps the higher or lower result."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomDiceArgs](),
+		mcp.WithOutputSchema[randomDiceResponse](),
+	)
+
+	addTool(diceTool, randomDiceHandler)
+
+	colorTool := mcp.NewTool(
+		"random_color",
+		mcp.WithDescription("Returns a cryptographically secure random color. Optional argument: format (hex, rgb, or hsl, default hex)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomColorArgs](),
+		mcp.WithOutputSchema[randomColorResponse](),
+	)
+
+	addTool(colorTool, randomColorHandler)
+
+	dateTool := mcp.NewTool(
+		"random_date",
+		mcp.WithDescription("Returns a cryptographically secure random timestamp between start and end (RFC3339). Required arguments: start, end. Optional argument: granularity (second, day, or month, default second)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomDateArgs](),
+		mcp.WithOutputSchema[randomDateResponse](),
+	)
+
+	addTool(dateTool, randomDateHandler)
+
+	normalIntTool := mcp.NewTool(
+		"random_normal_int",
+		mcp.WithDescription("Returns an integer from a normal distribution with the given mean (default 0) and stddev (default 1), rounded to the nearest int64 and optionally clamped to [min, max]."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomNormalIntArgs](),
+		mcp.WithOutputSchema[randomNormalIntResponse](),
+	)
+
+	addTool(normalIntTool, randomNormalIntHandler)
+
+	hexTool := mcp.NewTool(
+		"random_hex",
+		mcp.WithDescription("Returns a cryptographically secure hex string from the given number of random bytes (output is 2*bytes characters), suitable for API keys and nonces."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomHexArgs](),
+		mcp.WithOutputSchema[randomHexResponse](),
+	)
+
+	addTool(hexTool, randomHexHandler)
+
+	permutationTool := mcp.NewTool(
+		"random_permutation",
+		mcp.WithDescription("Returns a uniformly random permutation of the integers 0 through n-1."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomPermutationArgs](),
+		mcp.WithOutputSchema[randomPermutationResponse](),
+	)
+
+	addTool(permutationTool, randomPermutationHandler)
+
+	primeTool := mcp.NewTool(
+		"random_prime",
+		mcp.WithDescription("Returns a random probable prime with the given bit length (2 to 4096), returned as a decimal string."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomPrimeArgs](),
+		mcp.WithOutputSchema[randomPrimeResponse](),
+	)
+
+	addTool(primeTool, randomPrimeHandler)
+
+	macTool := mcp.NewTool(
+		"random_mac",
+		mcp.WithDescription("Returns a random MAC address; defaults to a locally administered unicast address, with optional multicast and local flags."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomMACArgs](),
+		mcp.WithOutputSchema[randomMACResponse](),
+	)
+
+	addTool(macTool, randomMACHandler)
+
+	ipTool := mcp.NewTool(
+		"random_ip",
+		mcp.WithDescription("Returns a random IPv4 or IPv6 address, optionally within a CIDR such as 10.0.0.0/8 (version is inferred from the CIDR, default 4)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomIPArgs](),
+		mcp.WithOutputSchema[randomIPResponse](),
+	)
+
+	addTool(ipTool, randomIPHandler)
+
+	wordTool := mcp.NewTool(
+		"random_word",
+		mcp.WithDescription("Returns one or more random English words from an embedded 7776-word list; optional count (default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomWordArgs](),
+		mcp.WithOutputSchema[randomWordResponse](),
+	)
+
+	addTool(wordTool, randomWordHandler)
+
+	passphraseTool := mcp.NewTool(
+		"random_passphrase",
+		mcp.WithDescription("Returns a Diceware-style passphrase of the given number of words from an embedded 7776-word list, joined by separator (default \"-\")."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomPassphraseArgs](),
+		mcp.WithOutputSchema[randomPassphraseResponse](),
+	)
+
+	addTool(passphraseTool, randomPassphraseHandler)
+
+	poissonTool := mcp.NewTool(
+		"random_poisson",
+		mcp.WithDescription("Returns a count from a Poisson distribution with mean lambda (required, greater than zero)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomPoissonArgs](),
+		mcp.WithOutputSchema[randomPoissonResponse](),
+	)
+
+	addTool(poissonTool, randomPoissonHandler)
+
+	binomialTool := mcp.NewTool(
+		"random_binomial",
+		mcp.WithDescription("Returns the number of successes in n independent trials that each succeed with probability p (0 to 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomBinomialArgs](),
+		mcp.WithOutputSchema[randomBinomialResponse](),
+	)
+
+	addTool(binomialTool, randomBinomialHandler)
+
+	jitterTool := mcp.NewTool(
+		"random_jitter",
+		mcp.WithDescription("Returns a randomized duration in [base*(1-jitter), base*(1+jitter)] for retry backoff. Arguments: base (a Go duration string such as \"5s\", must be positive), jitter (fraction between 0 and 1). The response gives durationMillis in the unit it names."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomJitterArgs](),
+		mcp.WithOutputSchema[randomJitterResponse](),
+	)
+
+	addTool(jitterTool, randomJitterHandler)
+
+	truncatedNormalTool := mcp.NewTool(
+		"random_truncated_normal",
+		mcp.WithDescription("Returns a sample from a normal distribution truncated to [min, max]. Out-of-range draws are resampled rather than clamped, and the response reports how many were rejected. Arguments: min, max (min must be less than max). Optional arguments: mean (default 0), stddev (default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomTruncatedNormalArgs](),
+		mcp.WithOutputSchema[randomTruncatedNormalResponse](),
+	)
+
+	addTool(truncatedNormalTool, randomTruncatedNormalHandler)
+
+	tokenTool := mcp.NewTool(
+		"random_token",
+		mcp.WithDescription("Returns a cryptographically secure base64 token for sessions, CSRF protection, and similar secrets. Arguments: bytes (number of random bytes to encode). Optional arguments: urlSafe (use the URL-safe alphabet, default true), padding (include trailing = padding, default false)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomTokenArgs](),
+		mcp.WithOutputSchema[randomTokenResponse](),
+	)
+
+	addTool(tokenTool, randomTokenHandler)
+
+	indexTool := mcp.NewTool(
+		"random_index",
+		mcp.WithDescription("Returns cryptographically secure random indices into a list the client holds, without sending the items. Arguments: size (length of the list). Optional argument: count (number of independent indices to return, default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomIndexArgs](),
+		mcp.WithOutputSchema[randomIndexResponse](),
+	)
+
+	addTool(indexTool, randomIndexHandler)
+
+	weightedIntTool := mcp.NewTool(
+		"random_weighted_int",
+		mcp.WithDescription("Returns one integer chosen from values in proportion to the parallel weights, for loot tables and similar weighted draws. Arguments: values, weights (one non-negative, finite weight per value with a positive sum). The response includes the chosen value's probability."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomWeightedIntArgs](),
+		mcp.WithOutputSchema[randomWeightedIntResponse](),
+	)
+
+	addTool(weightedIntTool, randomWeightedIntHandler)
+
+	histogramTool := mcp.NewTool(
+		"random_histogram",
+		mcp.WithDescription("Draws count samples from a distribution and returns a bucketed histogram instead of the raw values, for sanity-checking the generator. Arguments: distribution (uniform, normal, or exponential), count. Optional arguments: buckets (default 10), min and max (uniform, default 0 and 1), mean and stddev (normal, default 0 and 1), rate (exponential, default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomHistogramArgs](),
+		mcp.WithOutputSchema[randomHistogramResponse](),
+	)
+
+	addTool(histogramTool, randomHistogramHandler)
+
+	durationTool := mcp.NewTool(
+		"random_duration",
+		mcp.WithDescription("Returns a random duration between min and max, inclusive, for injecting randomized delays. Arguments: min, max (Go duration strings such as \"100ms\" or \"2s\"; neither may be negative). The response gives nanos in the unit it names."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomDurationArgs](),
+		mcp.WithOutputSchema[randomDurationResponse](),
+	)
+
+	addTool(durationTool, randomDurationHandler)
+
+	geoTool := mcp.NewTool(
+		"random_geo",
+		mcp.WithDescription("Returns a random latitude/longitude in degrees, distributed uniformly over the Earth's surface rather than clustering at the poles. Optional arguments: minLat, maxLat (default -90 and 90), minLon, maxLon (default -180 and 180; boxes crossing the antimeridian are not supported)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomGeoArgs](),
+		mcp.WithOutputSchema[randomGeoResponse](),
+	)
+
+	addTool(geoTool, randomGeoHandler)
+
+	walkTool := mcp.NewTool(
+		"random_walk",
+		mcp.WithDescription("Returns a Gaussian random walk for simulating time series such as prices or sensor readings. Each of steps values adds an independent normal increment to the previous one. Arguments: steps (1-100000). Optional arguments: start (default 0), drift (mean increment, default 0), volatility (increment standard deviation, default 1; 0 gives a straight line)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomWalkArgs](),
+		mcp.WithOutputSchema[randomWalkResponse](),
+	)
+
+	addTool(walkTool, randomWalkHandler)
+
+	loremTool := mcp.NewTool(
+		"random_lorem",
+		mcp.WithDescription("Returns lorem ipsum placeholder text for mocking up user interfaces, built from randomly chosen words in sentences of random length, each capitalized and ending with a period. Arguments: exactly one of words (total words, 1-10000) or sentences (number of sentences, 1-1000)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomLoremArgs](),
+		mcp.WithOutputSchema[randomLoremResponse](),
+	)
+
+	addTool(loremTool, randomLoremHandler)
+
+	slugTool := mcp.NewTool(
+		"random_slug",
+		mcp.WithDescription("Returns a readable, URL-friendly slug such as \"brave-amber-otter-4821\" for naming provisioned resources: random adjectives followed by a noun. Optional arguments: parts (number of words, 1-10, default 3), separator (URL unreserved characters only, default \"-\"), suffix (append a random 4-digit number, default false)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomSlugArgs](),
+		mcp.WithOutputSchema[randomSlugResponse](),
+	)
+
+	addTool(slugTool, randomSlugHandler)
+
+	bivariateNormalTool := mcp.NewTool(
+		"random_bivariate_normal",
+		mcp.WithDescription("Returns a pair of correlated normal samples x and y for simulations. Optional arguments: meanX, meanY (default 0), stddevX, stddevY (default 1, must be positive), correlation (between -1 and 1, default 0)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomBivariateNormalArgs](),
+		mcp.WithOutputSchema[randomBivariateNormalResponse](),
+	)
+
+	addTool(bivariateNormalTool, randomBivariateNormalHandler)
+
+	modularTool := mcp.NewTool(
+		"random_modular",
+		mcp.WithDescription("Returns a random value in the cyclic range [0, modulus-1], such as an hour of the day (modulus 24) or a clock position. Arguments: modulus (must be positive). Optional argument: offset (added modulo modulus; may be negative, default 0)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomModularArgs](),
+		mcp.WithOutputSchema[randomModularResponse](),
+	)
+
+	addTool(modularTool, randomModularHandler)
+
+	intStreamTool := mcp.NewTool(
+		"random_int_stream",
+		mcp.WithDescription("Streams up to 10000000 random integers in [min, max] without buffering them, for bulk generation. Requires a progressToken in the request _meta: values arrive in notifications/progress messages whose params carry a values array of up to chunkSize integers alongside progress and total, and the final result only summarizes the stream. Arguments: count. Optional arguments: min, max (default to the server's random_int bounds), chunkSize (1-10000, default 1000)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomIntStreamArgs](),
+		mcp.WithOutputSchema[randomIntStreamResponse](),
+	)
+
+	addTool(intStreamTool, randomIntStreamHandler)
+
+	cardTool := mcp.NewTool(
+		"random_card",
+		mcp.WithDescription("Draws count cards (default 1) without replacement from a securely shuffled standard 52-card deck. Cards are rank then suit, e.g. AS, 10H, KD. Set jokers to add a black (BJ) and red (RJ) joker to the deck."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomCardArgs](),
+		mcp.WithOutputSchema[randomCardResponse](),
+	)
+
+	addTool(cardTool, randomCardHandler)
+
+	bellDieTool := mcp.NewTool(
+		"random_bell_die",
+		mcp.WithDescription("Rolls dice dice with sides sides each and returns their sum. Summing several dice gives a bell-shaped distribution centered on dice*(sides+1)/2, so middle totals are most likely; the structured response includes the individual rolls and this theoretical mean."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomBellDieArgs](),
+		mcp.WithOutputSchema[randomBellDieResponse](),
+	)
+
+	addTool(bellDieTool, randomBellDieHandler)
+
+	validateRangeTool := mcp.NewTool(
+		"validate_range",
+		mcp.WithDescription("Checks a range without generating a value. Takes the same min, max, includeMin, and includeMax arguments as random_int and reports whether random_int could produce a value, the effective inclusive bounds after exclusivity, and the number of possible values as a decimal string (it can exceed int64)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[validateRangeArgs](),
+		mcp.WithOutputSchema[validateRangeResponse](),
+	)
+
+	addTool(validateRangeTool, validateRangeHandler)
+
+	nameTool := mcp.NewTool(
+		"random_name",
+		mcp.WithDescription("Returns a random person name for synthetic test personas, chosen from small embedded, culturally varied lists. Optional argument: style (full, first, or last; default full)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomNameArgs](),
+		mcp.WithOutputSchema[randomNameResponse](),
+	)
+
+	addTool(nameTool, randomNameHandler)
+
+	emailTool := mcp.NewTool(
+		"random_email",
+		mcp.WithDescription("Returns a plausible but fake email address built from a random first name and surname, e.g. amara.okafor@example.com, for test data. Optional arguments: domain (default example.com, which is reserved and never delivers mail), digits (append a number from 0 to 99)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomEmailArgs](),
+		mcp.WithOutputSchema[randomEmailResponse](),
+	)
+
+	addTool(emailTool, randomEmailHandler)
+
+	bigIntTool := mcp.NewTool(
+		"random_bigint",
+		mcp.WithDescription("Returns a cryptographically secure random integer of arbitrary size as a decimal string, removing random_int's int64 limit. Pass either bits (1-4096; the value is drawn from [0, 2^bits)) or minString and maxString (decimal integers of up to 4096 bits; the range is inclusive)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomBigIntArgs](),
+		mcp.WithOutputSchema[randomBigIntResponse](),
+	)
+
+	addTool(bigIntTool, randomBigIntHandler)
+
+	samplesTool := mcp.NewTool(
+		"random_samples",
+		mcp.WithDescription("Draws count samples from a uniform, normal, or exponential distribution in one call. Parameters: min/max for uniform (default [0, 1)), mean/stddev for normal (default 0/1), rate for exponential (default 1)."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomSamplesArgs](),
+		mcp.WithOutputSchema[randomSamplesResponse](),
+	)
+
+	addTool(samplesTool, randomSamplesHandler)
+
+	normalQuantileTool := mcp.NewTool(
+		"normal_quantile",
+		mcp.WithDescription("Returns the value at a given probability quantile of a normal distribution, e.g. probability 0.95 for the 95th percentile. Analytic, not random: uses Acklam's inverse normal CDF approximation. mean defaults to 0 and stddev to 1."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[normalQuantileArgs](),
+		mcp.WithOutputSchema[normalQuantileResponse](),
+	)
+
+	addTool(normalQuantileTool, normalQuantileHandler)
+
+	dicePoolTool := mcp.NewTool(
+		"random_dice_pool",
+		mcp.WithDescription("Rolls a pool of dice and counts successes: dice that meet or exceed target. Arguments: dice (1-1000), sides (1-1000000), target, and optional explode (a die showing its top face is rolled again and the roll added). Returns the number of successes and each die's result."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomDicePoolArgs](),
+		mcp.WithOutputSchema[randomDicePoolResponse](),
+	)
+
+	addTool(dicePoolTool, randomDicePoolHandler)
+
+	mapChoiceTool := mcp.NewTool(
+		"random_map_choice",
+		mcp.WithDescription("Picks a random entry from a map given as parallel keys and values arrays, returning the chosen key, its value, and its index. Keys must be unique and both arrays the same non-zero length."),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithInputSchema[randomMapChoiceArgs](),
+		mcp.WithOutputSchema[randomMapChoiceResponse](),
+	)
+
+	addTool(mapChoiceTool, randomMapChoiceHandler)
 
 	return mcpServer
 }
@@ -106,16 +677,12 @@ func NewMCPServer(name, version string) *server.MCPServer {
 func randomIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	var args randomIntArgs
 	if err := request.BindArguments(&args); err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_int failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_int", err), nil
 	}
 
-	min := int64(0)
-	max := int64(math.MaxInt64)
+	cfg := configFromContext(ctx)
+	min := cfg.defaultIntMin
+	max := cfg.defaultIntMax
 	includeMin := true
 	includeMax := true
 	if args.Min != nil {
@@ -124,6 +691,20 @@ func randomIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 	if args.Max != nil {
 		max = *args.Max
 	}
+	if args.MinString != nil {
+		parsed, err := strconv.ParseInt(*args.MinString, 10, 64)
+		if err != nil {
+			return toolErrorResult("random_int", fmt.Errorf("minString: %w", err)), nil
+		}
+		min = parsed
+	}
+	if args.MaxString != nil {
+		parsed, err := strconv.ParseInt(*args.MaxString, 10, 64)
+		if err != nil {
+			return toolErrorResult("random_int", fmt.Errorf("maxString: %w", err)), nil
+		}
+		max = parsed
+	}
 	if args.IncludeMin != nil {
 		includeMin = *args.IncludeMin
 	}
@@ -131,47 +712,75 @@ func randomIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 		includeMax = *args.IncludeMax
 	}
 
-	adjustedMin := min
-	adjustedMax := max
-	if args.Min != nil && !includeMin {
-		if min == math.MaxInt64 {
-			return &mcp.CallToolResult{
-				IsError: true,
-				Content: []mcp.Content{
-					mcp.TextContent{Type: "text", Text: "random_int failed: min cannot be excluded when min is MaxInt64"},
-				},
-			}, nil
-		}
-		adjustedMin = min + 1
+	minProvided := args.Min != nil || args.MinString != nil
+	maxProvided := args.Max != nil || args.MaxString != nil
+	adjustedMin, adjustedMax, err := effectiveIntBounds(min, max, minProvided, maxProvided, includeMin, includeMax)
+	if err != nil {
+		return toolErrorResult("random_int", err), nil
 	}
-	if args.Max != nil && !includeMax {
-		if max == math.MinInt64 {
-			return &mcp.CallToolResult{
-				IsError: true,
-				Content: []mcp.Content{
-					mcp.TextContent{Type: "text", Text: "random_int failed: max cannot be excluded when max is MinInt64"},
-				},
-			}, nil
-		}
-		adjustedMax = max - 1
+
+	count := 1
+	if args.Count != nil {
+		count = *args.Count
+	}
+	if count <= 0 || count > maxIntCount {
+		return toolErrorResult("random_int", fmt.Errorf("count must be between 1 and %d", maxIntCount)), nil
+	}
+
+	step := int64(1)
+	if args.Step != nil {
+		step = *args.Step
+	}
+	if step <= 0 {
+		return toolErrorResult("random_int", errors.New("step must be greater than zero")), nil
+	}
+
+	base := 10
+	if args.Base != nil {
+		base = *args.Base
+	}
+	if base < minIntBase || base > maxIntBase {
+		return toolErrorResult("random_int", fmt.Errorf("base must be between %d and %d", minIntBase, maxIntBase)), nil
+	}
+
+	slog.InfoContext(ctx, "randomIntHandler", slog.Int64("min", min), slog.Int64("max", max), slog.Bool("includeMin", includeMin), slog.Bool("includeMax", includeMax), slog.Int("count", count), slog.Int64("step", step), slog.Int("base", base))
+	exclude := make(map[int64]bool, len(args.Exclude))
+	for _, value := range args.Exclude {
+		exclude[value] = true
 	}
 
-	slog.InfoContext(ctx, "randomIntHandler", slog.Int64("min", min), slog.Int64("max", max), slog.Bool("includeMin", includeMin), slog.Bool("includeMax", includeMax))
-	value, err := randomInt64InRange(adjustedMin, adjustedMax)
+	values, err := randomInts(ctx, count, adjustedMin, adjustedMax, step, exclude)
+	if err != nil {
+		return toolErrorResult("random_int", err), nil
+	}
+	probability, err := intProbability(adjustedMin, adjustedMax, step, exclude)
 	if err != nil {
+		return toolErrorResult("random_int", err), nil
+	}
+
+	if count == 1 {
+		value := values[0]
+		slog.InfoContext(ctx, "randomIntHandler", slog.Int64("result", value))
+
+		response := randomIntResponse{Value: value, EffectiveMin: adjustedMin, EffectiveMax: adjustedMax, Step: step, Exclude: args.Exclude, Probability: probability, SchemaVersion: schemaVersions["random_int"], Algorithm: algorithmCryptoRand}
 		return &mcp.CallToolResult{
-			IsError: true,
 			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_int failed: %v", err)},
+				mcp.TextContent{Type: "text", Text: strconv.FormatInt(value, base)},
 			},
+			StructuredContent: response,
 		}, nil
 	}
-	slog.InfoContext(ctx, "randomIntHandler", slog.Int64("result", value))
 
-	response := randomIntResponse{Value: value}
+	lines := make([]string, count)
+	for i, value := range values {
+		lines[i] = strconv.FormatInt(value, base)
+	}
+	slog.InfoContext(ctx, "randomIntHandler", slog.Int("results", count))
+
+	response := randomIntResponse{Value: values[0], Values: values, EffectiveMin: adjustedMin, EffectiveMax: adjustedMax, Step: step, Exclude: args.Exclude, Probability: probability, SchemaVersion: schemaVersions["random_int"], Algorithm: algorithmCryptoRand}
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%d", value)},
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
 		},
 		StructuredContent: response,
 	}, nil
@@ -180,12 +789,7 @@ func randomIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 func randomFloatHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	var args randomFloatArgs
 	if err := request.BindArguments(&args); err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_float failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_float", err), nil
 	}
 
 	min := 0.0
@@ -205,20 +809,48 @@ func randomFloatHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 		includeMax = *args.IncludeMax
 	}
 
-	value, err := randomFloat64InRange(min, max, includeMin, includeMax, args.Min != nil, args.Max != nil)
+	if args.Decimals != nil && (*args.Decimals < 0 || *args.Decimals > maxFloatDecimals) {
+		return toolErrorResult("random_float", fmt.Errorf("decimals must be between 0 and %d", maxFloatDecimals)), nil
+	}
+
+	// Exclusivity only applies to bounds the caller provided, never to the defaults.
+	includeMin = includeMin || args.Min == nil
+	includeMax = includeMax || args.Max == nil
+
+	var value float64
+	var err error
+	if args.LogUniform {
+		value, err = randomLogUniform(ctx, min, max, includeMin, includeMax)
+	} else {
+		value, err = sourceFromContext(ctx).Float64(min, max, includeMin, includeMax)
+	}
+	lo, hi := adjustFloatBounds(min, max, includeMin, includeMax)
+	if err == nil && args.Decimals != nil {
+		value, err = roundFloatWithin(value, lo, hi, *args.Decimals)
+	}
 	if err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_float failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_float", err), nil
+	}
+
+	text := fmt.Sprintf("%g", value)
+	if args.Decimals != nil {
+		text = strconv.FormatFloat(value, 'f', *args.Decimals, 64)
 	}
 
-	response := randomFloatResponse{Value: value}
+	response := randomFloatResponse{
+		Value:         value,
+		Decimals:      args.Decimals,
+		LogUniform:    args.LogUniform,
+		MinProvided:   args.Min != nil,
+		MaxProvided:   args.Max != nil,
+		EffectiveMin:  lo,
+		EffectiveMax:  hi,
+		SchemaVersion: schemaVersions["random_float"],
+		Algorithm:     algorithmCryptoRand,
+	}
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%g", value)},
+			mcp.TextContent{Type: "text", Text: text},
 		},
 		StructuredContent: response,
 	}, nil
@@ -227,25 +859,32 @@ func randomFloatHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 func randomASCIIHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	var args randomASCIIArgs
 	if err := request.BindArguments(&args); err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_ascii failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_ascii", err), nil
+	}
+
+	if maxLength := configFromContext(ctx).maxASCIILength; args.Length > maxLength {
+		return toolErrorResult("random_ascii", fmt.Errorf("length cannot exceed %d", maxLength)), nil
+	}
+
+	min := asciiPrintableMin
+	max := asciiPrintableMax
+	if args.Min != nil {
+		min = *args.Min
+	}
+	if args.Max != nil {
+		max = *args.Max
+	}
+	if min < 0 || max > maxASCIICode || min > max {
+		return toolErrorResult("random_ascii", fmt.Errorf("min and max must satisfy 0 <= min <= max <= %d", maxASCIICode)), nil
 	}
 
-	value, err := randomASCIIString(args.Length)
+	value, err := randomASCIIInRange(ctx, args.Length, byte(min), byte(max))
 	if err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_ascii failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_ascii", err), nil
 	}
+	slog.InfoContext(ctx, "randomASCIIHandler", slog.Int("length", args.Length), resultAttr("random_ascii", value))
 
-	response := randomASCIIResponse{Value: value}
+	response := randomASCIIResponse{Value: value, Min: min, Max: max, EntropyBits: entropyBits(args.Length, max-min+1), SchemaVersion: schemaVersions["random_ascii"], Algorithm: algorithmCryptoRand}
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			mcp.TextContent{Type: "text", Text: value},
@@ -257,25 +896,30 @@ func randomASCIIHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 func randomStringHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	var args randomStringArgs
 	if err := request.BindArguments(&args); err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_string failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_string", err), nil
+	}
+
+	charset := args.Charset
+	charsetName := args.Charset
+	if args.Preset != "" {
+		if args.Charset != "" {
+			return toolErrorResult("random_string", errors.New("charset and preset cannot both be set")), nil
+		}
+		presetCharset, ok := charsetPresets[args.Preset]
+		if !ok {
+			return toolErrorResult("random_string", fmt.Errorf("unknown preset %q", args.Preset)), nil
+		}
+		charset = presetCharset
+		charsetName = args.Preset
 	}
 
-	value, err := randomStringWithCharset(args.Length, args.Charset)
+	value, err := randomStringWithCharset(ctx, args.Length, charset)
 	if err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				mcp.TextContent{Type: "text", Text: fmt.Sprintf("random_string failed: %v", err)},
-			},
-		}, nil
+		return toolErrorResult("random_string", err), nil
 	}
+	slog.InfoContext(ctx, "randomStringHandler", slog.Int("length", args.Length), slog.String("charset", charsetName), resultAttr("random_string", value))
 
-	response := randomStringResponse{Value: value}
+	response := randomStringResponse{Value: value, Charset: charsetName, EntropyBits: charsetEntropyBits(args.Length, charset), SchemaVersion: schemaVersions["random_string"], Algorithm: algorithmCryptoRand}
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			mcp.TextContent{Type: "text", Text: value},
@@ -284,100 +928,268 @@ func randomStringHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp
 	}, nil
 }
 
-// randomInt64InRange returns a cryptographically secure random integer in the
-// inclusive range [min, max].
-func randomInt64InRange(min, max int64) (int64, error) {
-	minBig := big.NewInt(min)
-	maxBig := big.NewInt(max)
-	if minBig.Cmp(maxBig) > 0 {
-		return 0, fmt.Errorf("min cannot be greater than max")
+// effectiveIntBounds applies random_int's exclusivity rules to [min, max] and returns the
+// inclusive range values are drawn from. Exclusivity only applies to bounds the caller
+// provided, never to the configured defaults. It does not check that min <= max.
+func effectiveIntBounds(min, max int64, minProvided, maxProvided, includeMin, includeMax bool) (int64, int64, error) {
+	adjustedMin := min
+	adjustedMax := max
+	if minProvided && !includeMin {
+		if min == math.MaxInt64 {
+			return 0, 0, errors.New("min cannot be excluded when min is MaxInt64")
+		}
+		adjustedMin = min + 1
+	}
+	if maxProvided && !includeMax {
+		if max == math.MinInt64 {
+			return 0, 0, errors.New("max cannot be excluded when max is MinInt64")
+		}
+		adjustedMax = max - 1
 	}
+	// A valid range can still be emptied by exclusivity, e.g. min=5, max=5, includeMin=false.
+	// Report that directly instead of as the min > max error the adjusted bounds would produce.
+	if min <= max && adjustedMin > adjustedMax {
+		return 0, 0, errExclusivityEmptiesRange
+	}
+	return adjustedMin, adjustedMax, nil
+}
 
-	rangeSize := new(big.Int).Sub(maxBig, minBig)
-	rangeSize.Add(rangeSize, big.NewInt(1))
-	value, err := rand.Int(rand.Reader, rangeSize)
+// randomMultipleInRange returns a cryptographically secure random multiple of step in the
+// inclusive range [min, max]. Step must be greater than zero and at least one multiple
+// must lie in the range.
+func randomMultipleInRange(ctx context.Context, min, max, step int64) (int64, error) {
+	if step == 1 {
+		return sourceFromContext(ctx).Int64(min, max)
+	}
+	first, last, err := multipleBounds(min, max, step)
 	if err != nil {
 		return 0, err
 	}
 
-	value.Add(value, minBig)
-	return value.Int64(), nil
+	offset, err := sourceFromContext(ctx).Int64(0, new(big.Int).Sub(last, first).Int64())
+	if err != nil {
+		return 0, err
+	}
+
+	value := first.Add(first, big.NewInt(offset))
+	return value.Mul(value, big.NewInt(step)).Int64(), nil
 }
 
-func randomFloat64InRange(min, max float64, includeMin, includeMax, hasMin, hasMax bool) (float64, error) {
-	if math.IsNaN(min) || math.IsNaN(max) {
-		return 0, fmt.Errorf("min and max must not be NaN")
-	}
-	if math.IsInf(min, 0) || math.IsInf(max, 0) {
-		return 0, fmt.Errorf("min and max must be finite")
+// multipleBounds returns the smallest and largest quotients q such that q*step lies in
+// the inclusive range [min, max].
+func multipleBounds(min, max, step int64) (*big.Int, *big.Int, error) {
+	if step <= 0 {
+		return nil, nil, fmt.Errorf("step must be greater than zero")
 	}
 	if min > max {
-		return 0, fmt.Errorf("min cannot be greater than max")
+		return nil, nil, &RangeError{}
 	}
-	if min == max {
-		if includeMin && includeMax {
-			return min, nil
+
+	stepBig := big.NewInt(step)
+	// Div rounds toward negative infinity for a positive divisor, so negating around it yields the ceiling.
+	first := new(big.Int).Neg(big.NewInt(min))
+	first.Div(first, stepBig)
+	first.Neg(first)
+	last := new(big.Int).Div(big.NewInt(max), stepBig)
+	if first.Cmp(last) > 0 {
+		return nil, nil, fmt.Errorf("no multiple of %d in range [%d, %d]", step, min, max)
+	}
+	return first, last, nil
+}
+
+// intProbability returns the chance of drawing any one allowed value: 1 divided by the number
+// of multiples of step in [min, max] that are not in exclude.
+func intProbability(min, max, step int64, exclude map[int64]bool) (float64, error) {
+	first, last, err := multipleBounds(min, max, step)
+	if err != nil {
+		return 0, err
+	}
+	outcomes := new(big.Int).Sub(last, first)
+	outcomes.Add(outcomes, big.NewInt(1))
+	for value := range exclude {
+		if value >= min && value <= max && value%step == 0 {
+			outcomes.Sub(outcomes, big.NewInt(1))
 		}
-		return 0, fmt.Errorf("range is empty when min equals max and is excluded")
 	}
+	if outcomes.Sign() <= 0 {
+		return 0, fmt.Errorf("every value in range is excluded")
+	}
+	probability, _ := new(big.Float).Quo(big.NewFloat(1), new(big.Float).SetInt(outcomes)).Float64()
+	return probability, nil
+}
 
-	adjustedMin := min
-	adjustedMax := max
-	if hasMin && !includeMin {
-		adjustedMin = math.Nextafter(min, math.Inf(1))
+// randomInts returns count independent draws from randomIntExcluding, stopping early with
+// ctx.Err() if ctx is done.
+func randomInts(ctx context.Context, count int, min, max, step int64, exclude map[int64]bool) ([]int64, error) {
+	values := make([]int64, count)
+	for i := range values {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		value, err := randomIntExcluding(ctx, min, max, step, exclude)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
 	}
-	if hasMax && !includeMax {
-		adjustedMax = math.Nextafter(max, math.Inf(-1))
+	return values, nil
+}
+
+// randomIntExcluding returns a random multiple of step in [min, max] that does not appear in exclude.
+// It rejection-samples up to maxExclusionAttempts times and then, when the range holds no more than
+// maxExplicitCandidates values, chooses uniformly from the explicitly enumerated allowed values.
+func randomIntExcluding(ctx context.Context, min, max, step int64, exclude map[int64]bool) (int64, error) {
+	for attempt := 0; attempt < maxExclusionAttempts; attempt++ {
+		value, err := randomMultipleInRange(ctx, min, max, step)
+		if err != nil {
+			return 0, err
+		}
+		if !exclude[value] {
+			return value, nil
+		}
+	}
+
+	first, last, err := multipleBounds(min, max, step)
+	if err != nil {
+		return 0, err
+	}
+	candidates := new(big.Int).Sub(last, first)
+	if candidates.Cmp(big.NewInt(maxExplicitCandidates)) >= 0 {
+		return 0, fmt.Errorf("unable to find a value outside the exclusion list after %d attempts", maxExclusionAttempts)
+	}
+
+	// Count up from first rather than comparing against last, which may be math.MaxInt64.
+	var allowed []int64
+	for i := range candidates.Int64() + 1 {
+		if value := (first.Int64() + i) * step; !exclude[value] {
+			allowed = append(allowed, value)
+		}
 	}
-	if adjustedMin > adjustedMax {
-		return 0, fmt.Errorf("range is empty after applying exclusivity")
+	if len(allowed) == 0 {
+		return 0, fmt.Errorf("every value in range is excluded")
 	}
 
-	unit, err := cryptoRandFloat64()
+	index, err := randomIndex(ctx, len(allowed))
 	if err != nil {
 		return 0, err
 	}
+	return allowed[index], nil
+}
 
-	return adjustedMin + unit*(adjustedMax-adjustedMin), nil
+// requireFinite reports an error naming the argument when v is NaN or infinite.
+func requireFinite(name string, v float64) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("%s must be finite", name)
+	}
+	return nil
 }
 
-func cryptoRandFloat64() (float64, error) {
-	const maxUint53 = 1 << 53
-	value, err := rand.Int(rand.Reader, big.NewInt(maxUint53))
+// randomLogUniform returns a value whose logarithm is uniform between ln(min) and ln(max), so
+// that each order of magnitude in the range is equally likely: exp(ln(min) + u*(ln(max)-ln(min))).
+// min must be greater than zero and max greater than min. Exclusivity applies in log space, and
+// the result is clamped so rounding in exp cannot step outside the adjusted bounds.
+func randomLogUniform(ctx context.Context, min, max float64, includeMin, includeMax bool) (float64, error) {
+	if math.IsNaN(min) || math.IsInf(min, 0) {
+		return 0, &NonFiniteError{Value: min}
+	}
+	if math.IsNaN(max) || math.IsInf(max, 0) {
+		return 0, &NonFiniteError{Value: max}
+	}
+	if min <= 0 {
+		return 0, fmt.Errorf("logUniform requires min greater than zero")
+	}
+	if min > max {
+		return 0, &RangeError{}
+	}
+	if min == max {
+		return 0, fmt.Errorf("logUniform requires max greater than min")
+	}
+
+	exponent, err := sourceFromContext(ctx).Float64(math.Log(min), math.Log(max), includeMin, includeMax)
 	if err != nil {
 		return 0, err
 	}
-	return float64(value.Int64()) / float64(maxUint53), nil
+	lo, hi := adjustFloatBounds(min, max, includeMin, includeMax)
+	return math.Min(math.Max(math.Exp(exponent), lo), hi), nil
 }
 
-// randomASCIIString returns a cryptographically secure random string of printable ASCII characters.
-// Length must be greater than zero.
-func randomASCIIString(length int) (string, error) {
-	if length <= 0 {
-		return "", &ZeroLengthError{}
+// adjustFloatBounds applies exclusivity to the bounds and returns the inclusive range
+// that random_float values are drawn from.
+func adjustFloatBounds(min, max float64, includeMin, includeMax bool) (float64, float64) {
+	adjustedMin := min
+	adjustedMax := max
+	if !includeMin {
+		adjustedMin = math.Nextafter(min, math.Inf(1))
+	}
+	if !includeMax {
+		adjustedMax = math.Nextafter(max, math.Inf(-1))
 	}
+	return adjustedMin, adjustedMax
+}
 
-	const asciiStart = 32
-	const asciiEnd = 126
-	const asciiRange = asciiEnd - asciiStart + 1
+// roundFloatWithin rounds value to the given number of decimal places while keeping it
+// inside the inclusive range [lo, hi]. When rounding to nearest would leave the range,
+// the value is rounded toward the interior instead.
+func roundFloatWithin(value, lo, hi float64, decimals int) (float64, error) {
+	scale := math.Pow10(decimals)
+	scaled := value * scale
+	if math.IsInf(scaled, 0) {
+		// Values this large have no fractional digits left to round away.
+		return value, nil
+	}
 
-	var builder strings.Builder
-	builder.Grow(length)
-	max := big.NewInt(asciiRange)
-	for i := 0; i < length; i++ {
-		value, err := rand.Int(rand.Reader, max)
-		if err != nil {
-			return "", err
+	rounded := math.Round(scaled) / scale
+	if rounded < lo {
+		rounded = math.Ceil(scaled) / scale
+	}
+	if rounded > hi {
+		rounded = math.Floor(scaled) / scale
+	}
+	if rounded < lo || rounded > hi {
+		return 0, fmt.Errorf("no value with %d decimal places lies within the range", decimals)
+	}
+	return rounded, nil
+}
+
+// randomASCIIInRange returns a random string of length characters with codes drawn uniformly
+// from the inclusive range [min, max]. The default printable range uses the source's ASCII
+// method; narrower or wider ranges go through randomStringWithCharset. Either way it returns
+// ctx.Err() if ctx is done before the string is complete.
+func randomASCIIInRange(ctx context.Context, length int, min, max byte) (string, error) {
+	if min == asciiPrintableMin && max == asciiPrintableMax {
+		if length <= 0 {
+			return "", &ZeroLengthError{}
 		}
-		builder.WriteByte(byte(asciiStart + value.Int64()))
+		// Draw in chunks so a cancelled request stops between them.
+		var builder strings.Builder
+		builder.Grow(length)
+		for builder.Len() < length {
+			if err := ctx.Err(); err != nil {
+				return "", err
+			}
+			n := length - builder.Len()
+			if n > cancelCheckInterval {
+				n = cancelCheckInterval
+			}
+			chunk, err := sourceFromContext(ctx).ASCII(n)
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString(chunk)
+		}
+		return builder.String(), nil
 	}
 
-	return builder.String(), nil
+	charset := make([]byte, 0, int(max-min)+1)
+	for c := int(min); c <= int(max); c++ {
+		charset = append(charset, byte(c))
+	}
+	return randomStringWithCharset(ctx, length, string(charset))
 }
 
 // randomStringWithCharset returns a cryptographically secure random string using the provided charset.
 // Length must be greater than zero and charset must not be empty.
-func randomStringWithCharset(length int, charset string) (string, error) {
+func randomStringWithCharset(ctx context.Context, length int, charset string) (string, error) {
 	if length <= 0 {
 		return "", &ZeroLengthError{}
 	}
@@ -390,7 +1202,10 @@ func randomStringWithCharset(length int, charset string) (string, error) {
 	var builder strings.Builder
 	max := big.NewInt(int64(len(charsetRunes)))
 	for i := 0; i < length; i++ {
-		value, err := rand.Int(rand.Reader, max)
+		if err := checkCancelled(ctx, i); err != nil {
+			return "", err
+		}
+		value, err := rand.Int(sourceFromContext(ctx), max)
 		if err != nil {
 			return "", err
 		}
@@ -1,11 +1,18 @@
 package random
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func TestRandomIntHandler(t *testing.T) {
@@ -22,6 +29,19 @@ func TestRandomIntHandler(t *testing.T) {
 			min:     0,
 			max:     math.MaxInt64,
 		},
+		{
+			desc: "valid request with full int64 span",
+			request: mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]any{
+						"min": int64(math.MinInt64),
+						"max": int64(math.MaxInt64),
+					},
+				},
+			},
+			min: math.MinInt64,
+			max: math.MaxInt64,
+		},
 		{
 			desc: "valid request with min only",
 			request: mcp.CallToolRequest{
@@ -149,13 +169,635 @@ func TestRandomIntHandler(t *testing.T) {
 	ctx := t.Context()
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
-			result, err := randomIntHandler(ctx, tc.request)
+			result, err := randomIntHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomIntHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomIntHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomIntHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			valueFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+			if err != nil {
+				t.Fatalf("randomIntHandler() invalid text content: %v", err)
+			}
+			if valueFromText < tc.min || valueFromText > tc.max {
+				t.Fatalf("randomIntHandler() text value out of range: %d", valueFromText)
+			}
+
+			structured, ok := result.StructuredContent.(randomIntResponse)
+			if !ok {
+				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomIntHandler() structured value %d != text value %d", structured.Value, valueFromText)
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerCount(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		count   int
+		wantErr bool
+	}{
+		{
+			desc: "valid request with count 1",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"min": int64(1), "max": int64(6), "count": 1,
+			}}},
+			count: 1,
+		},
+		{
+			desc: "valid request with count 5",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"min": int64(1), "max": int64(6), "count": 5,
+			}}},
+			count: 5,
+		},
+		{
+			desc: "valid request with maximum count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"min": int64(1), "max": int64(6), "count": maxIntCount,
+			}}},
+			count: maxIntCount,
+		},
+		{
+			desc: "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"count": 0,
+			}}},
+			wantErr: true,
+		},
+		{
+			desc: "invalid request with count over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+				"count": maxIntCount + 1,
+			}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomIntHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomIntHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomIntHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomIntHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			lines := strings.Split(textContent.Text, "\n")
+			if len(lines) != tc.count {
+				t.Fatalf("randomIntHandler() text has %d lines, want %d", len(lines), tc.count)
+			}
+
+			structured, ok := result.StructuredContent.(randomIntResponse)
+			if !ok {
+				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+			}
+			if tc.count == 1 {
+				if structured.Values != nil {
+					t.Fatalf("randomIntHandler() structured values = %v, want nil for a single draw", structured.Values)
+				}
+				return
+			}
+			if len(structured.Values) != tc.count {
+				t.Fatalf("randomIntHandler() structured values length = %d, want %d", len(structured.Values), tc.count)
+			}
+			if structured.Value != structured.Values[0] {
+				t.Fatalf("randomIntHandler() structured value %d != first value %d", structured.Value, structured.Values[0])
+			}
+			for i, line := range lines {
+				value, err := strconv.ParseInt(line, 10, 64)
+				if err != nil {
+					t.Fatalf("randomIntHandler() invalid text line %q: %v", line, err)
+				}
+				if value < 1 || value > 6 {
+					t.Fatalf("randomIntHandler() value out of range: %d", value)
+				}
+				if value != structured.Values[i] {
+					t.Fatalf("randomIntHandler() text value %d != structured value %d", value, structured.Values[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerStep(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		min     int64
+		max     int64
+		step    int64
+		wantErr bool
+	}{
+		{
+			desc: "default step",
+			args: map[string]any{"min": int64(1), "max": int64(6)},
+			min:  1,
+			max:  6,
+			step: 1,
+		},
+		{
+			desc: "even numbers",
+			args: map[string]any{"min": int64(1), "max": int64(10), "step": int64(2)},
+			min:  2,
+			max:  10,
+			step: 2,
+		},
+		{
+			desc: "multiples of five across zero",
+			args: map[string]any{"min": int64(-12), "max": int64(12), "step": int64(5)},
+			min:  -10,
+			max:  10,
+			step: 5,
+		},
+		{
+			desc: "multiples with excluded bounds",
+			args: map[string]any{"min": int64(0), "max": int64(10), "step": int64(5), "includeMin": false, "includeMax": false},
+			min:  5,
+			max:  5,
+			step: 5,
+		},
+		{
+			desc: "full int64 span",
+			args: map[string]any{"min": int64(math.MinInt64), "max": int64(math.MaxInt64), "step": int64(3)},
+			min:  math.MinInt64,
+			max:  math.MaxInt64,
+			step: 3,
+		},
+		{
+			desc:    "zero step",
+			args:    map[string]any{"min": int64(1), "max": int64(10), "step": int64(0)},
+			wantErr: true,
+		},
+		{
+			desc:    "negative step",
+			args:    map[string]any{"min": int64(1), "max": int64(10), "step": int64(-2)},
+			wantErr: true,
+		},
+		{
+			desc:    "no multiple in range",
+			args:    map[string]any{"min": int64(1), "max": int64(6), "step": int64(7)},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			for i := 0; i < 20; i++ {
+				result, err := randomIntHandler(ctx, request)
+				if err != nil {
+					t.Fatalf("randomIntHandler() error = %v", err)
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomIntHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+				}
+
+				structured, ok := result.StructuredContent.(randomIntResponse)
+				if !ok {
+					t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+				}
+				if structured.Step != tc.step {
+					t.Fatalf("randomIntHandler() structured step = %d, want %d", structured.Step, tc.step)
+				}
+				if structured.Value < tc.min || structured.Value > tc.max {
+					t.Fatalf("randomIntHandler() value out of range: %d", structured.Value)
+				}
+				if structured.Value%tc.step != 0 {
+					t.Fatalf("randomIntHandler() value %d is not a multiple of %d", structured.Value, tc.step)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerExclude(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		min     int64
+		max     int64
+		exclude []int64
+		wantErr bool
+	}{
+		{
+			desc:    "partial exclusion",
+			args:    map[string]any{"min": int64(1), "max": int64(6), "exclude": []int64{2, 4, 6}},
+			min:     1,
+			max:     6,
+			exclude: []int64{2, 4, 6},
+		},
+		{
+			desc:    "all but one value excluded",
+			args:    map[string]any{"min": int64(1), "max": int64(10), "exclude": []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+			min:     10,
+			max:     10,
+			exclude: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			desc:    "exclusion combined with step",
+			args:    map[string]any{"min": int64(0), "max": int64(20), "step": int64(5), "exclude": []int64{0, 5, 10, 15}},
+			min:     20,
+			max:     20,
+			exclude: []int64{0, 5, 10, 15},
+		},
+		{
+			desc:    "exclusion in a large range",
+			args:    map[string]any{"exclude": []int64{0, 1, 2}},
+			min:     3,
+			max:     math.MaxInt64,
+			exclude: []int64{0, 1, 2},
+		},
+		{
+			desc:    "every value excluded",
+			args:    map[string]any{"min": int64(1), "max": int64(3), "exclude": []int64{1, 2, 3}},
+			wantErr: true,
+		},
+		{
+			desc:    "all but one value excluded up to MaxInt64",
+			args:    map[string]any{"min": int64(math.MaxInt64 - 2), "max": int64(math.MaxInt64), "exclude": []int64{math.MaxInt64 - 1, math.MaxInt64}},
+			min:     math.MaxInt64 - 2,
+			max:     math.MaxInt64 - 2,
+			exclude: []int64{math.MaxInt64 - 1, math.MaxInt64},
+		},
+		{
+			desc:    "every value excluded up to MaxInt64",
+			args:    map[string]any{"min": int64(math.MaxInt64 - 1), "max": int64(math.MaxInt64), "exclude": []int64{math.MaxInt64 - 1, math.MaxInt64}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			for i := 0; i < 20; i++ {
+				result, err := randomIntHandler(ctx, request)
+				if err != nil {
+					t.Fatalf("randomIntHandler() error = %v", err)
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomIntHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+				}
+
+				structured, ok := result.StructuredContent.(randomIntResponse)
+				if !ok {
+					t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+				}
+				if structured.Value < tc.min || structured.Value > tc.max {
+					t.Fatalf("randomIntHandler() value out of range: %d", structured.Value)
+				}
+				if slices.Contains(tc.exclude, structured.Value) {
+					t.Fatalf("randomIntHandler() returned excluded value %d", structured.Value)
+				}
+				if !slices.Equal(structured.Exclude, tc.exclude) {
+					t.Fatalf("randomIntHandler() structured exclude = %v, want %v", structured.Exclude, tc.exclude)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerEffectiveBounds(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		wantMin int64
+		wantMax int64
+	}{
+		{
+			desc:    "defaults",
+			args:    map[string]any{},
+			wantMin: 0,
+			wantMax: math.MaxInt64,
+		},
+		{
+			desc:    "inclusive bounds",
+			args:    map[string]any{"min": int64(3), "max": int64(9)},
+			wantMin: 3,
+			wantMax: 9,
+		},
+		{
+			desc:    "exclusive min",
+			args:    map[string]any{"min": int64(3), "max": int64(9), "includeMin": false},
+			wantMin: 4,
+			wantMax: 9,
+		},
+		{
+			desc:    "exclusive max",
+			args:    map[string]any{"min": int64(3), "max": int64(9), "includeMax": false},
+			wantMin: 3,
+			wantMax: 8,
+		},
+		{
+			desc:    "exclusive min and max with count",
+			args:    map[string]any{"min": int64(-5), "max": int64(5), "includeMin": false, "includeMax": false, "count": 3},
+			wantMin: -4,
+			wantMax: 4,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomIntHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomIntResponse)
+			if !ok {
+				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+			}
+			if structured.EffectiveMin != tc.wantMin || structured.EffectiveMax != tc.wantMax {
+				t.Fatalf("randomIntHandler() effective range = [%d, %d], want [%d, %d]", structured.EffectiveMin, structured.EffectiveMax, tc.wantMin, tc.wantMax)
+			}
+			if structured.Value < structured.EffectiveMin || structured.Value > structured.EffectiveMax {
+				t.Fatalf("randomIntHandler() value = %d, outside effective range [%d, %d]", structured.Value, structured.EffectiveMin, structured.EffectiveMax)
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerEmptyAfterExclusivity(t *testing.T) {
+	testCases := []struct {
+		desc string
+		args map[string]any
+		want string
+	}{
+		{
+			desc: "equal bounds with min excluded",
+			args: map[string]any{"min": int64(5), "max": int64(5), "includeMin": false},
+			want: "random_int failed: range is empty after applying exclusivity",
+		},
+		{
+			desc: "equal bounds with max excluded",
+			args: map[string]any{"min": int64(5), "max": int64(5), "includeMax": false},
+			want: "random_int failed: range is empty after applying exclusivity",
+		},
+		{
+			desc: "equal bounds with both excluded",
+			args: map[string]any{"min": int64(5), "max": int64(5), "includeMin": false, "includeMax": false},
+			want: "random_int failed: range is empty after applying exclusivity",
+		},
+		{
+			desc: "adjacent bounds with both excluded",
+			args: map[string]any{"min": int64(-1), "max": int64(0), "includeMin": false, "includeMax": false},
+			want: "random_int failed: range is empty after applying exclusivity",
+		},
+		{
+			desc: "inverted bounds keep range error",
+			args: map[string]any{"min": int64(6), "max": int64(5), "includeMin": false},
+			want: "random_int failed: min cannot be greater than max",
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomIntHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if !result.IsError {
+				t.Fatalf("randomIntHandler() expected error, got success: %+v", result.StructuredContent)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomIntHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			if textContent.Text != tc.want {
+				t.Fatalf("randomIntHandler() error text = %q, want %q", textContent.Text, tc.want)
+			}
+		})
+	}
+
+	// Excluding one end of a two-value range leaves exactly one value to draw.
+	result, err := randomIntHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": int64(5), "max": int64(6), "includeMin": false}}})
+	if err != nil {
+		t.Fatalf("randomIntHandler() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+	}
+	if structured := result.StructuredContent.(randomIntResponse); structured.Value != 6 {
+		t.Fatalf("randomIntHandler() value = %d, want 6", structured.Value)
+	}
+}
+
+func TestRandomIntHandlerBase(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		base    int
+		wantErr bool
+	}{
+		{desc: "binary", args: map[string]any{"min": int64(0), "max": int64(255), "base": 2}, base: 2},
+		{desc: "hexadecimal", args: map[string]any{"min": int64(0), "max": int64(math.MaxInt64), "base": 16}, base: 16},
+		{desc: "base36 with negatives", args: map[string]any{"min": int64(-1000000), "max": int64(-1), "base": 36}, base: 36},
+		{desc: "base16 with count", args: map[string]any{"min": int64(0), "max": int64(4095), "count": 5, "base": 16}, base: 16},
+		{desc: "default base", args: map[string]any{"min": int64(-9), "max": int64(9)}, base: 10},
+		{desc: "base too small", args: map[string]any{"base": 1}, wantErr: true},
+		{desc: "base too large", args: map[string]any{"base": 37}, wantErr: true},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomIntHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomIntHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomIntResponse)
+			if !ok {
+				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+			}
+			values := structured.Values
+			if values == nil {
+				values = []int64{structured.Value}
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomIntHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			lines := strings.Split(textContent.Text, "\n")
+			if len(lines) != len(values) {
+				t.Fatalf("randomIntHandler() text has %d lines, want %d", len(lines), len(values))
+			}
+			for i, line := range lines {
+				parsed, err := strconv.ParseInt(line, tc.base, 64)
+				if err != nil {
+					t.Fatalf("randomIntHandler() text %q is not base %d: %v", line, tc.base, err)
+				}
+				if parsed != values[i] {
+					t.Fatalf("randomIntHandler() text %q parses to %d, want structured value %d", line, parsed, values[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerProbability(t *testing.T) {
+	testCases := []struct {
+		desc string
+		args map[string]any
+		want float64
+	}{
+		{desc: "six-sided die", args: map[string]any{"min": 1, "max": 6}, want: 1.0 / 6},
+		{desc: "single value", args: map[string]any{"min": 4, "max": 4}, want: 1},
+		{desc: "exclusive min", args: map[string]any{"min": 1, "max": 6, "includeMin": false}, want: 1.0 / 5},
+		{desc: "step", args: map[string]any{"min": 0, "max": 9, "step": 2}, want: 1.0 / 5},
+		{desc: "exclude", args: map[string]any{"min": 1, "max": 6, "exclude": []int64{3, 3, 5}}, want: 1.0 / 4},
+		{desc: "exclude outside range or step", args: map[string]any{"min": 0, "max": 9, "step": 2, "exclude": []int64{3, 12}}, want: 1.0 / 5},
+		{desc: "default range", args: nil, want: 1.0 / (1 << 63)},
+		{desc: "full int64 span", args: map[string]any{"min": int64(math.MinInt64), "max": int64(math.MaxInt64)}, want: 1.0 / (1 << 64)},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			result, err := randomIntHandler(ctx, request)
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+			}
+			structured, ok := result.StructuredContent.(randomIntResponse)
+			if !ok {
+				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+			}
+			if math.Abs(structured.Probability-tc.want) > tc.want*1e-12 {
+				t.Fatalf("randomIntHandler() probability = %g, want %g", structured.Probability, tc.want)
+			}
+		})
+	}
+}
+
+func TestRandomIntHandlerStringBounds(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    string
+		wantMin int64
+		wantMax int64
+		wantErr bool
+	}{
+		{
+			desc:    "numeric value above 2^53 is rounded",
+			args:    `{"min":9007199254740993,"max":9007199254740993}`,
+			wantMin: 9007199254740992,
+			wantMax: 9007199254740992,
+		},
+		{
+			desc:    "string value above 2^53 is exact",
+			args:    `{"minString":"9007199254740993","maxString":"9007199254740993"}`,
+			wantMin: 9007199254740993,
+			wantMax: 9007199254740993,
+		},
+		{
+			desc:    "strings take precedence over numbers",
+			args:    `{"min":1,"max":2,"minString":"9223372036854775806","maxString":"9223372036854775807"}`,
+			wantMin: 9223372036854775806,
+			wantMax: 9223372036854775807,
+		},
+		{
+			desc:    "negative string bound with numeric max",
+			args:    `{"minString":"-9223372036854775808","max":-9223372036854775000,"includeMin":false}`,
+			wantMin: math.MinInt64 + 1,
+			wantMax: -9223372036854775000,
+		},
+		{
+			desc:    "string bound overflows int64",
+			args:    `{"maxString":"9223372036854775808"}`,
+			wantErr: true,
+		},
+		{
+			desc:    "string bound is not an integer",
+			args:    `{"minString":"1e3"}`,
+			wantErr: true,
+		},
+		{
+			desc:    "string bounds out of order",
+			args:    `{"minString":"9007199254740994","maxString":"9007199254740993"}`,
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Decode the arguments as the transports do, so numeric bounds go through float64.
+			var args map[string]any
+			if err := json.Unmarshal([]byte(tc.args), &args); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			result, err := randomIntHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
 			if err != nil {
 				t.Fatalf("randomIntHandler() error = %v", err)
 			}
-			if result == nil || len(result.Content) == 0 {
-				t.Fatalf("randomIntHandler() result is nil or empty")
-			}
 			if tc.wantErr {
 				if !result.IsError {
 					t.Fatalf("randomIntHandler() expected error, got success")
@@ -166,25 +808,162 @@ func TestRandomIntHandler(t *testing.T) {
 				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
 			}
 
-			textContent, ok := result.Content[0].(mcp.TextContent)
+			structured, ok := result.StructuredContent.(randomIntResponse)
 			if !ok {
-				t.Fatalf("randomIntHandler() content type = %T, want TextContent", result.Content[0])
+				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+			}
+			if structured.EffectiveMin != tc.wantMin || structured.EffectiveMax != tc.wantMax {
+				t.Fatalf("randomIntHandler() effective range = [%d, %d], want [%d, %d]", structured.EffectiveMin, structured.EffectiveMax, tc.wantMin, tc.wantMax)
+			}
+			if structured.Value < tc.wantMin || structured.Value > tc.wantMax {
+				t.Fatalf("randomIntHandler() value %d out of range [%d, %d]", structured.Value, tc.wantMin, tc.wantMax)
 			}
+		})
+	}
+}
 
-			valueFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+func TestRangeErrorsKeepResponseText(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{
+			desc:    "random_int min greater than max",
+			handler: randomIntHandler,
+			args:    map[string]any{"min": 5, "max": 1},
+			want:    "random_int failed: min cannot be greater than max",
+		},
+		{
+			desc:    "random_float min greater than max",
+			handler: randomFloatHandler,
+			args:    map[string]any{"min": 5.0, "max": 1.0},
+			want:    "random_float failed: min cannot be greater than max",
+		},
+		{
+			desc:    "random_float excluded equal bounds",
+			handler: randomFloatHandler,
+			args:    map[string]any{"min": 1.0, "max": 1.0, "includeMax": false},
+			want:    "random_float failed: range is empty when min equals max and is excluded",
+		},
+		{
+			desc:    "random_normal_int min greater than max",
+			handler: randomNormalIntHandler,
+			args:    map[string]any{"min": 5, "max": 1},
+			want:    "random_normal_int failed: min cannot be greater than max",
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := tc.handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
 			if err != nil {
-				t.Fatalf("randomIntHandler() invalid text content: %v", err)
+				t.Fatalf("%s handler error = %v", tc.desc, err)
 			}
-			if valueFromText < tc.min || valueFromText > tc.max {
-				t.Fatalf("randomIntHandler() text value out of range: %d", valueFromText)
+			if !result.IsError {
+				t.Fatalf("%s handler expected error, got success", tc.desc)
+			}
+			if got := result.Content[0].(mcp.TextContent).Text; got != tc.want {
+				t.Fatalf("%s handler text = %q, want %q", tc.desc, got, tc.want)
 			}
+		})
+	}
+}
 
-			structured, ok := result.StructuredContent.(randomIntResponse)
-			if !ok {
-				t.Fatalf("randomIntHandler() structured content type = %T, want randomIntResponse", result.StructuredContent)
+func TestInternalRangeErrorsAreTyped(t *testing.T) {
+	var rangeErr *RangeError
+	if _, _, err := multipleBounds(5, 1, 1); !errors.As(err, &rangeErr) {
+		t.Fatalf("multipleBounds() error = %v, want *RangeError", err)
+	}
+	min, max := int64(5), int64(1)
+	if _, err := randomNormalInt(t.Context(), 0, 1, &min, &max); !errors.As(err, &rangeErr) {
+		t.Fatalf("randomNormalInt() error = %v, want *RangeError", err)
+	}
+}
+
+func TestStructuredResponsesReportAlgorithm(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+	}{
+		{desc: "random_int", handler: randomIntHandler},
+		{desc: "random_int with count", handler: randomIntHandler, args: map[string]any{"count": 3}},
+		{desc: "random_float", handler: randomFloatHandler},
+		{desc: "random_ascii", handler: randomASCIIHandler, args: map[string]any{"length": 8}},
+		{desc: "random_string", handler: randomStringHandler, args: map[string]any{"length": 8, "charset": "abc"}},
+		{desc: "random_bytes", handler: randomBytesHandler, args: map[string]any{"length": 8}},
+		{desc: "random_bool", handler: randomBoolHandler},
+		{desc: "random_choice", handler: randomChoiceHandler, args: map[string]any{"items": []string{"a", "b"}}},
+		{desc: "random_sample", handler: randomSampleHandler, args: map[string]any{"items": []string{"a", "b"}, "k": 1}},
+		{desc: "random_shuffle", handler: randomShuffleHandler, args: map[string]any{"items": []string{"a", "b"}}},
+		{desc: "random_gaussian", handler: randomGaussianHandler},
+		{desc: "random_exponential", handler: randomExponentialHandler},
+		{desc: "random_password", handler: randomPasswordHandler, args: map[string]any{"length": 8}},
+		{desc: "random_dice", handler: randomDiceHandler, args: map[string]any{"notation": "2d6"}},
+		{desc: "random_color", handler: randomColorHandler},
+		{desc: "random_date", handler: randomDateHandler, args: map[string]any{"start": "2024-01-01T00:00:00Z", "end": "2024-12-31T00:00:00Z"}},
+		{desc: "random_normal_int", handler: randomNormalIntHandler},
+		{desc: "random_hex", handler: randomHexHandler, args: map[string]any{"bytes": 16}},
+		{desc: "random_permutation", handler: randomPermutationHandler, args: map[string]any{"n": 10}},
+		{desc: "random_prime", handler: randomPrimeHandler, args: map[string]any{"bits": 16}},
+		{desc: "random_mac", handler: randomMACHandler},
+		{desc: "random_ip", handler: randomIPHandler, args: map[string]any{"cidr": "10.0.0.0/8"}},
+		{desc: "random_word", handler: randomWordHandler, args: map[string]any{"count": 2}},
+		{desc: "random_passphrase", handler: randomPassphraseHandler, args: map[string]any{"words": 4}},
+		{desc: "random_poisson", handler: randomPoissonHandler, args: map[string]any{"lambda": 4.0}},
+		{desc: "random_binomial", handler: randomBinomialHandler, args: map[string]any{"n": 10, "p": 0.5}},
+		{desc: "random_jitter", handler: randomJitterHandler, args: map[string]any{"base": "5s", "jitter": 0.2}},
+		{desc: "random_truncated_normal", handler: randomTruncatedNormalHandler, args: map[string]any{"min": -1.0, "max": 1.0}},
+		{desc: "random_token", handler: randomTokenHandler, args: map[string]any{"bytes": 16}},
+		{desc: "random_index", handler: randomIndexHandler, args: map[string]any{"size": 10}},
+		{desc: "random_weighted_int", handler: randomWeightedIntHandler, args: map[string]any{"values": []any{1, 2}, "weights": []any{1.0, 1.0}}},
+		{desc: "random_histogram", handler: randomHistogramHandler, args: map[string]any{"distribution": "uniform", "count": 10}},
+		{desc: "random_duration", handler: randomDurationHandler, args: map[string]any{"min": "1s", "max": "2s"}},
+		{desc: "random_geo", handler: randomGeoHandler},
+		{desc: "random_walk", handler: randomWalkHandler, args: map[string]any{"steps": 3}},
+		{desc: "random_lorem", handler: randomLoremHandler, args: map[string]any{"words": 5}},
+		{desc: "random_slug", handler: randomSlugHandler},
+		{desc: "random_bivariate_normal", handler: randomBivariateNormalHandler},
+		{desc: "random_modular", handler: randomModularHandler, args: map[string]any{"modulus": 24}},
+		{desc: "random_card", handler: randomCardHandler},
+		{desc: "random_bell_die", handler: randomBellDieHandler, args: map[string]any{"dice": 3, "sides": 6}},
+		{desc: "random_name", handler: randomNameHandler},
+		{desc: "random_email", handler: randomEmailHandler},
+		{desc: "random_bigint", handler: randomBigIntHandler, args: map[string]any{"bits": 128}},
+		{desc: "random_samples", handler: randomSamplesHandler, args: map[string]any{"distribution": "normal", "count": 3}},
+		{desc: "random_dice_pool", handler: randomDicePoolHandler, args: map[string]any{"dice": 5, "sides": 10, "target": 8}},
+		{desc: "random_map_choice", handler: randomMapChoiceHandler, args: map[string]any{"keys": []string{"a", "b"}, "values": []string{"1", "2"}}},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			result, err := tc.handler(ctx, request)
+			if err != nil {
+				t.Fatalf("%s handler error = %v", tc.desc, err)
 			}
-			if structured.Value != valueFromText {
-				t.Fatalf("randomIntHandler() structured value %d != text value %d", structured.Value, valueFromText)
+			if result.IsError {
+				t.Fatalf("%s handler returned error content: %+v", tc.desc, result.Content[0])
+			}
+
+			encoded, err := json.Marshal(result.StructuredContent)
+			if err != nil {
+				t.Fatalf("%s structured content marshal error = %v", tc.desc, err)
+			}
+			var fields map[string]any
+			if err := json.Unmarshal(encoded, &fields); err != nil {
+				t.Fatalf("%s structured content unmarshal error = %v", tc.desc, err)
+			}
+			if fields["algorithm"] != "crypto/rand" {
+				t.Fatalf("%s structured algorithm = %v, want %q", tc.desc, fields["algorithm"], "crypto/rand")
+			}
+			tool, _, _ := strings.Cut(tc.desc, " ")
+			if want := float64(schemaVersions[tool]); want == 0 || fields["schemaVersion"] != want {
+				t.Fatalf("%s structured schemaVersion = %v, want %v", tc.desc, fields["schemaVersion"], want)
 			}
 		})
 	}
@@ -208,6 +987,141 @@ func TestNewMCPServerRegistersTool(t *testing.T) {
 	if _, ok := tools["random_string"]; !ok {
 		t.Fatalf("NewMCPServer() missing random_string tool")
 	}
+	if _, ok := tools["random_bytes"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_bytes tool")
+	}
+	if _, ok := tools["random_bool"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_bool tool")
+	}
+	if _, ok := tools["random_choice"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_choice tool")
+	}
+	if _, ok := tools["random_sample"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_sample tool")
+	}
+	if _, ok := tools["random_shuffle"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_shuffle tool")
+	}
+	if _, ok := tools["random_gaussian"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_gaussian tool")
+	}
+	if _, ok := tools["random_exponential"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_exponential tool")
+	}
+	if _, ok := tools["random_password"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_password tool")
+	}
+	if _, ok := tools["random_dice"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_dice tool")
+	}
+	if _, ok := tools["random_color"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_color tool")
+	}
+	if _, ok := tools["random_date"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_date tool")
+	}
+	if _, ok := tools["random_normal_int"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_normal_int tool")
+	}
+	if _, ok := tools["random_hex"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_hex tool")
+	}
+	if _, ok := tools["random_permutation"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_permutation tool")
+	}
+	if _, ok := tools["random_prime"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_prime tool")
+	}
+	if _, ok := tools["random_mac"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_mac tool")
+	}
+	if _, ok := tools["random_ip"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_ip tool")
+	}
+	if _, ok := tools["random_word"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_word tool")
+	}
+	if _, ok := tools["random_passphrase"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_passphrase tool")
+	}
+	if _, ok := tools["random_poisson"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_poisson tool")
+	}
+	if _, ok := tools["random_binomial"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_binomial tool")
+	}
+	if _, ok := tools["random_jitter"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_jitter tool")
+	}
+	if _, ok := tools["random_truncated_normal"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_truncated_normal tool")
+	}
+	if _, ok := tools["random_token"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_token tool")
+	}
+	if _, ok := tools["random_index"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_index tool")
+	}
+	if _, ok := tools["random_weighted_int"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_weighted_int tool")
+	}
+	if _, ok := tools["random_histogram"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_histogram tool")
+	}
+	if _, ok := tools["random_duration"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_duration tool")
+	}
+	if _, ok := tools["random_geo"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_geo tool")
+	}
+	if _, ok := tools["random_walk"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_walk tool")
+	}
+	if _, ok := tools["random_lorem"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_lorem tool")
+	}
+	if _, ok := tools["random_slug"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_slug tool")
+	}
+	if _, ok := tools["random_bivariate_normal"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_bivariate_normal tool")
+	}
+	if _, ok := tools["random_modular"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_modular tool")
+	}
+	if _, ok := tools["random_int_stream"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_int_stream tool")
+	}
+	if _, ok := tools["random_card"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_card tool")
+	}
+	if _, ok := tools["random_bell_die"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_bell_die tool")
+	}
+	if _, ok := tools["validate_range"]; !ok {
+		t.Fatalf("NewMCPServer() missing validate_range tool")
+	}
+	if _, ok := tools["random_name"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_name tool")
+	}
+	if _, ok := tools["random_email"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_email tool")
+	}
+	if _, ok := tools["random_bigint"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_bigint tool")
+	}
+	if _, ok := tools["random_samples"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_samples tool")
+	}
+	if _, ok := tools["normal_quantile"]; !ok {
+		t.Fatalf("NewMCPServer() missing normal_quantile tool")
+	}
+	if _, ok := tools["random_dice_pool"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_dice_pool tool")
+	}
+	if _, ok := tools["random_map_choice"]; !ok {
+		t.Fatalf("NewMCPServer() missing random_map_choice tool")
+	}
 }
 
 func TestRandomFloatHandler(t *testing.T) {
@@ -457,15 +1371,243 @@ func TestRandomFloatHandler(t *testing.T) {
 			if structured.Value != valueFromText {
 				t.Fatalf("randomFloatHandler() structured value %f != text value %f", structured.Value, valueFromText)
 			}
+			if structured.MinProvided != tc.minProvided || structured.MaxProvided != tc.maxProvided {
+				t.Fatalf("randomFloatHandler() provided = %v/%v, want %v/%v", structured.MinProvided, structured.MaxProvided, tc.minProvided, tc.maxProvided)
+			}
+			wantMin, wantMax := tc.min, tc.max
+			if !tc.includeMin {
+				wantMin = math.Nextafter(tc.min, math.Inf(1))
+			}
+			if !tc.includeMax {
+				wantMax = math.Nextafter(tc.max, math.Inf(-1))
+			}
+			if structured.EffectiveMin != wantMin || structured.EffectiveMax != wantMax {
+				t.Fatalf("randomFloatHandler() effective range = [%g, %g], want [%g, %g]", structured.EffectiveMin, structured.EffectiveMax, wantMin, wantMax)
+			}
+		})
+	}
+}
+
+func TestRandomFloatHandlerDecimals(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		args     map[string]any
+		min      float64
+		max      float64
+		decimals int
+		wantErr  bool
+	}{
+		{
+			desc:     "two decimals",
+			args:     map[string]any{"min": 1.0, "max": 2.0, "decimals": 2},
+			min:      1,
+			max:      2,
+			decimals: 2,
+		},
+		{
+			desc:     "zero decimals with excluded bounds",
+			args:     map[string]any{"min": 0.0, "max": 2.0, "includeMin": false, "includeMax": false, "decimals": 0},
+			min:      1,
+			max:      1,
+			decimals: 0,
+		},
+		{
+			desc:     "rounding pulled back inside an excluded max",
+			args:     map[string]any{"min": 0.11, "max": 0.12, "includeMax": false, "decimals": 2},
+			min:      0.11,
+			max:      0.11,
+			decimals: 2,
+		},
+		{
+			desc:     "default range with decimals",
+			args:     map[string]any{"decimals": 3},
+			min:      0,
+			max:      math.MaxFloat64,
+			decimals: 3,
+		},
+		{
+			desc:    "no representable value in range",
+			args:    map[string]any{"min": 0.111, "max": 0.119, "decimals": 2},
+			wantErr: true,
+		},
+		{
+			desc:    "negative decimals",
+			args:    map[string]any{"decimals": -1},
+			wantErr: true,
+		},
+		{
+			desc:    "decimals over cap",
+			args:    map[string]any{"decimals": maxFloatDecimals + 1},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			for i := 0; i < 20; i++ {
+				result, err := randomFloatHandler(ctx, request)
+				if err != nil {
+					t.Fatalf("randomFloatHandler() error = %v", err)
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomFloatHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomFloatHandler() returned error content: %+v", result.Content[0])
+				}
+
+				textContent, ok := result.Content[0].(mcp.TextContent)
+				if !ok {
+					t.Fatalf("randomFloatHandler() content type = %T, want TextContent", result.Content[0])
+				}
+				valueFromText, err := strconv.ParseFloat(textContent.Text, 64)
+				if err != nil {
+					t.Fatalf("randomFloatHandler() invalid text content: %v", err)
+				}
+
+				structured, ok := result.StructuredContent.(randomFloatResponse)
+				if !ok {
+					t.Fatalf("randomFloatHandler() structured content type = %T, want randomFloatResponse", result.StructuredContent)
+				}
+				if structured.Value != valueFromText {
+					t.Fatalf("randomFloatHandler() structured value %v != text value %v", structured.Value, valueFromText)
+				}
+				if structured.Value < tc.min || structured.Value > tc.max {
+					t.Fatalf("randomFloatHandler() value out of range: %v", structured.Value)
+				}
+				if structured.Decimals == nil || *structured.Decimals != tc.decimals {
+					t.Fatalf("randomFloatHandler() structured decimals = %v, want %d", structured.Decimals, tc.decimals)
+				}
+				if got := strconv.FormatFloat(structured.Value, 'f', -1, 64); strings.Contains(got, ".") && len(got)-strings.Index(got, ".")-1 > tc.decimals {
+					t.Fatalf("randomFloatHandler() value %s has more than %d decimal places", got, tc.decimals)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomFloatHandlerLogUniform(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		min     float64
+		max     float64
+		wantErr bool
+	}{
+		{
+			desc: "learning rate range",
+			args: map[string]any{"min": 1e-5, "max": 1e-1, "logUniform": true},
+			min:  1e-5,
+			max:  1e-1,
+		},
+		{
+			desc: "excluded bounds",
+			args: map[string]any{"min": 1.0, "max": 1000.0, "includeMin": false, "includeMax": false, "logUniform": true},
+			min:  math.Nextafter(1, 2),
+			max:  math.Nextafter(1000, 0),
+		},
+		{
+			desc: "with decimals",
+			args: map[string]any{"min": 1.0, "max": 100.0, "decimals": 1, "logUniform": true},
+			min:  1,
+			max:  100,
+		},
+		{
+			desc:    "zero min",
+			args:    map[string]any{"min": 0.0, "max": 1.0, "logUniform": true},
+			wantErr: true,
+		},
+		{
+			desc:    "default min",
+			args:    map[string]any{"max": 1.0, "logUniform": true},
+			wantErr: true,
+		},
+		{
+			desc:    "negative min",
+			args:    map[string]any{"min": -1.0, "max": 1.0, "logUniform": true},
+			wantErr: true,
+		},
+		{
+			desc:    "equal bounds",
+			args:    map[string]any{"min": 2.0, "max": 2.0, "logUniform": true},
+			wantErr: true,
+		},
+		{
+			desc:    "min greater than max",
+			args:    map[string]any{"min": 10.0, "max": 1.0, "logUniform": true},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			for i := 0; i < 20; i++ {
+				result, err := randomFloatHandler(ctx, request)
+				if err != nil {
+					t.Fatalf("randomFloatHandler() error = %v", err)
+				}
+				if tc.wantErr {
+					if !result.IsError {
+						t.Fatalf("randomFloatHandler() expected error, got success")
+					}
+					return
+				}
+				if result.IsError {
+					t.Fatalf("randomFloatHandler() returned error content: %+v", result.Content[0])
+				}
+
+				structured, ok := result.StructuredContent.(randomFloatResponse)
+				if !ok {
+					t.Fatalf("randomFloatHandler() structured content type = %T, want randomFloatResponse", result.StructuredContent)
+				}
+				if !structured.LogUniform {
+					t.Fatalf("randomFloatHandler() logUniform = false, want true")
+				}
+				if structured.Value < tc.min || structured.Value > tc.max {
+					t.Fatalf("randomFloatHandler() value %v out of range [%v, %v]", structured.Value, tc.min, tc.max)
+				}
+			}
 		})
 	}
 }
 
+func TestRandomLogUniformSpreadsAcrossMagnitudes(t *testing.T) {
+	// Over [1e-4, 1) each of the four decades should hold about a quarter of the samples,
+	// where uniform sampling would put 90% of them in the top decade.
+	const samples = 20000
+	var decades [4]int
+	for range samples {
+		value, err := randomLogUniform(t.Context(), 1e-4, 1, true, false)
+		if err != nil {
+			t.Fatalf("randomLogUniform() error = %v", err)
+		}
+		decade := int(math.Floor(math.Log10(value))) + 4
+		if decade < 0 || decade >= len(decades) {
+			t.Fatalf("randomLogUniform() value %v out of range [1e-4, 1)", value)
+		}
+		decades[decade]++
+	}
+	for i, count := range decades {
+		if got := float64(count) / samples; math.Abs(got-0.25) > 0.02 {
+			t.Fatalf("randomLogUniform() decade 1e%d holds %.3f of samples, want about 0.25", i-4, got)
+		}
+	}
+}
+
 func TestRandomASCIIHandler(t *testing.T) {
 	testCases := []struct {
 		desc    string
 		request mcp.CallToolRequest
 		length  int
+		min     int
+		max     int
 		wantErr bool
 	}{
 		{
@@ -490,6 +1632,59 @@ func TestRandomASCIIHandler(t *testing.T) {
 			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 16}}},
 			length:  16,
 		},
+		{
+			desc:    "valid request at default max length",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": defaultMaxASCIILength}}},
+			length:  defaultMaxASCIILength,
+		},
+		{
+			desc:    "invalid request over default max length",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": defaultMaxASCIILength + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with uppercase range",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32, "min": 65, "max": 90}}},
+			length:  32,
+			min:     'A',
+			max:     'Z',
+		},
+		{
+			desc:    "valid request with only min",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32, "min": 33}}},
+			length:  32,
+			min:     33,
+			max:     asciiPrintableMax,
+		},
+		{
+			desc:    "valid request with a single character",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "min": 120, "max": 120}}},
+			length:  4,
+			min:     'x',
+			max:     'x',
+		},
+		{
+			desc:    "valid request including control characters",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 16, "min": 0, "max": 127}}},
+			length:  16,
+			min:     0,
+			max:     maxASCIICode,
+		},
+		{
+			desc:    "invalid request with min greater than max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "min": 90, "max": 65}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative min",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "min": -1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with max above ASCII",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "max": 128}}},
+			wantErr: true,
+		},
 	}
 
 	ctx := t.Context()
@@ -519,10 +1714,14 @@ func TestRandomASCIIHandler(t *testing.T) {
 			if len(textContent.Text) != tc.length {
 				t.Fatalf("randomASCIIHandler() text length = %d, want %d", len(textContent.Text), tc.length)
 			}
+			min, max := tc.min, tc.max
+			if max == 0 {
+				min, max = asciiPrintableMin, asciiPrintableMax
+			}
 			for i := 0; i < len(textContent.Text); i++ {
-				b := textContent.Text[i]
-				if b < 32 || b > 126 {
-					t.Fatalf("randomASCIIHandler() non-printable ASCII at index %d: %d", i, b)
+				b := int(textContent.Text[i])
+				if b < min || b > max {
+					t.Fatalf("randomASCIIHandler() character at index %d = %d, want within [%d, %d]", i, b, min, max)
 				}
 			}
 
@@ -530,6 +1729,9 @@ func TestRandomASCIIHandler(t *testing.T) {
 			if !ok {
 				t.Fatalf("randomASCIIHandler() structured content type = %T, want randomASCIIResponse", result.StructuredContent)
 			}
+			if structured.Min != min || structured.Max != max {
+				t.Fatalf("randomASCIIHandler() range = [%d, %d], want [%d, %d]", structured.Min, structured.Max, min, max)
+			}
 			if structured.Value != textContent.Text {
 				t.Fatalf("randomASCIIHandler() structured value != text value")
 			}
@@ -539,11 +1741,12 @@ func TestRandomASCIIHandler(t *testing.T) {
 
 func TestRandomStringHandler(t *testing.T) {
 	testCases := []struct {
-		desc    string
-		request mcp.CallToolRequest
-		length  int
-		charset string
-		wantErr bool
+		desc        string
+		request     mcp.CallToolRequest
+		length      int
+		charset     string
+		charsetName string
+		wantErr     bool
 	}{
 		{
 			desc:    "invalid request with zero length",
@@ -571,6 +1774,35 @@ func TestRandomStringHandler(t *testing.T) {
 			length:  5,
 			charset: "αβγ",
 		},
+		{
+			desc:        "valid request with numeric preset",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 12, "preset": "numeric"}}},
+			length:      12,
+			charset:     "0123456789",
+			charsetName: "numeric",
+		},
+		{
+			desc:        "valid request with alphanumeric preset",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 32, "preset": "alphanumeric"}}},
+			length:      32,
+			charset:     charsetPresets["alphanumeric"],
+			charsetName: "alphanumeric",
+		},
+		{
+			desc:    "invalid request with unknown preset",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "preset": "emoji"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with both charset and preset",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4, "charset": "abc", "preset": "hex"}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with neither charset nor preset",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"length": 4}}},
+			wantErr: true,
+		},
 	}
 
 	ctx := t.Context()
@@ -618,6 +1850,45 @@ func TestRandomStringHandler(t *testing.T) {
 			if structured.Value != textContent.Text {
 				t.Fatalf("randomStringHandler() structured value != text value")
 			}
+			wantCharset := tc.charsetName
+			if wantCharset == "" {
+				wantCharset = tc.charset
+			}
+			if structured.Charset != wantCharset {
+				t.Fatalf("randomStringHandler() structured charset = %q, want %q", structured.Charset, wantCharset)
+			}
 		})
 	}
 }
+
+func BenchmarkConcurrentTools(b *testing.B) {
+	calls := []struct {
+		handler server.ToolHandlerFunc
+		args    map[string]any
+	}{
+		{handler: randomBytesHandler, args: map[string]any{"length": 32}},
+		{handler: randomTokenHandler, args: map[string]any{"bytes": 32}},
+		{handler: randomASCIIHandler, args: map[string]any{"length": 64}},
+		{handler: randomFloatHandler, args: map[string]any{"min": 0.0, "max": 1.0}},
+		{handler: randomGaussianHandler, args: map[string]any{"count": 64}},
+	}
+
+	// Silence the per-call logging so the benchmark measures generation.
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.DiscardHandler))
+	b.Cleanup(func() { slog.SetDefault(previous) })
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := b.Context()
+		i := 0
+		for pb.Next() {
+			call := calls[i%len(calls)]
+			i++
+			result, err := call.handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: call.args}})
+			if err != nil || result.IsError {
+				b.Fatalf("handler failed: %v %+v", err, result)
+			}
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package random
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// requestIDAttr is the log attribute that carries a tool call's request ID.
+const requestIDAttr = "request_id"
+
+// requestIDBytes is the number of random bytes in a generated request ID.
+const requestIDBytes = 6
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id. Transports call it to forward an ID
+// supplied by the client, such as an X-Request-ID header; tool calls without one get a
+// generated ID from NewMCPServer.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// requestIDFromContext returns the ID attached by WithRequestID, if any.
+func requestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// newRequestID returns a short random hex ID.
+func newRequestID() string {
+	b := make([]byte, requestIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// requestIDMiddleware ensures every tool call's context carries a request ID, keeping
+// one forwarded by the transport and generating one otherwise.
+func requestIDMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, ok := requestIDFromContext(ctx); !ok {
+			ctx = WithRequestID(ctx, newRequestID())
+		}
+		return next(ctx, request)
+	}
+}
+
+// requestIDHandler adds the request_id attribute to records logged with a context
+// that carries a request ID.
+type requestIDHandler struct {
+	slog.Handler
+}
+
+// NewRequestIDHandler wraps h so that records logged with slog's *Context methods
+// include the tool call's request_id.
+func NewRequestIDHandler(h slog.Handler) slog.Handler {
+	return requestIDHandler{Handler: h}
+}
+
+func (h requestIDHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id, ok := requestIDFromContext(ctx); ok {
+		record = record.Clone()
+		record.AddAttrs(slog.String(requestIDAttr, id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h requestIDHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return requestIDHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h requestIDHandler) WithGroup(name string) slog.Handler {
+	return requestIDHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,82 @@
+package random
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// captureLogs routes the default logger through NewRequestIDHandler into a buffer for
+// the duration of the test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(NewRequestIDHandler(slog.NewJSONHandler(&buf, nil))))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestRequestIDCorrelatesHandlerLogs(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		id     string
+		wantID string
+	}{
+		{desc: "generated id"},
+		{desc: "forwarded id", id: "client-42", wantID: "client-42"},
+	}
+
+	mcpServer := NewMCPServer("test-server", "0.0.0")
+	message := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int","arguments":{"min":1,"max":6}}}`)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			buf := captureLogs(t)
+			ctx := t.Context()
+			if tc.id != "" {
+				ctx = WithRequestID(ctx, tc.id)
+			}
+			mcpServer.HandleMessage(ctx, message)
+
+			var ids []string
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				var record map[string]any
+				if err := json.Unmarshal([]byte(line), &record); err != nil {
+					t.Fatalf("log line %q: %v", line, err)
+				}
+				if record["msg"] != "randomIntHandler" {
+					continue
+				}
+				id, _ := record[requestIDAttr].(string)
+				ids = append(ids, id)
+			}
+
+			if len(ids) != 2 {
+				t.Fatalf("randomIntHandler logged %d lines, want start and result: %s", len(ids), buf)
+			}
+			if ids[0] == "" || ids[0] != ids[1] {
+				t.Fatalf("request ids = %q, want the same non-empty id on both lines", ids)
+			}
+			if tc.wantID != "" && ids[0] != tc.wantID {
+				t.Fatalf("request id = %q, want %q", ids[0], tc.wantID)
+			}
+		})
+	}
+}
+
+func TestNewRequestID(t *testing.T) {
+	seen := make(map[string]bool)
+	for range 100 {
+		id := newRequestID()
+		if len(id) != 2*requestIDBytes {
+			t.Fatalf("newRequestID() = %q, want %d hex characters", id, 2*requestIDBytes)
+		}
+		if seen[id] {
+			t.Fatalf("newRequestID() repeated %q", id)
+		}
+		seen[id] = true
+	}
+}
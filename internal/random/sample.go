@@ -0,0 +1,113 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxSampleReplacementK caps how many items random_sample draws with replacement, where k is
+// not otherwise bounded by the length of the list.
+const maxSampleReplacementK = 10000
+
+type randomSampleResponse struct {
+	Values        []string `json:"values"`
+	Indices       []int    `json:"indices"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Algorithm     string   `json:"algorithm"`
+}
+
+type randomSampleArgs struct {
+	Items []string `json:"items"`
+	K     int      `json:"k"`
+	// Replace draws each item independently, so k may exceed len(items) and duplicates are expected.
+	Replace bool `json:"replace,omitempty"`
+}
+
+func randomSampleHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomSampleArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_sample", err), nil
+	}
+
+	sample := randomSampleIndices
+	if args.Replace {
+		sample = randomSampleIndicesWithReplacement
+	}
+	indices, err := sample(ctx, len(args.Items), args.K)
+	if err != nil {
+		return toolErrorResult("random_sample", err), nil
+	}
+
+	values := make([]string, len(indices))
+	for i, index := range indices {
+		values[i] = args.Items[index]
+	}
+
+	response := randomSampleResponse{Values: values, Indices: indices, SchemaVersion: schemaVersions["random_sample"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(values, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomSampleIndices returns k distinct indices drawn uniformly without replacement from [0, n-1].
+// It performs a partial Fisher-Yates shuffle so that every k-permutation is equally likely.
+// K must satisfy 0 < k <= n.
+func randomSampleIndices(ctx context.Context, n, k int) ([]int, error) {
+	if k <= 0 {
+		return nil, fmt.Errorf("k must be greater than zero")
+	}
+	if k > n {
+		return nil, fmt.Errorf("k cannot be greater than the number of items (%d)", n)
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	for i := 0; i < k; i++ {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		j, err := sourceFromContext(ctx).Int64(int64(i), int64(n-1))
+		if err != nil {
+			return nil, err
+		}
+		indices[i], indices[j] = indices[j], indices[i]
+	}
+
+	return indices[:k], nil
+}
+
+// randomSampleIndicesWithReplacement returns k indices drawn independently and uniformly from
+// [0, n-1], as used for bootstrap resampling. Unlike randomSampleIndices, k may exceed n.
+// K must satisfy 0 < k <= maxSampleReplacementK and n must be greater than zero.
+func randomSampleIndicesWithReplacement(ctx context.Context, n, k int) ([]int, error) {
+	if k <= 0 {
+		return nil, fmt.Errorf("k must be greater than zero")
+	}
+	if k > maxSampleReplacementK {
+		return nil, fmt.Errorf("k cannot be greater than %d", maxSampleReplacementK)
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("items must not be empty")
+	}
+
+	indices := make([]int, k)
+	for i := range indices {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		j, err := sourceFromContext(ctx).Int64(0, int64(n-1))
+		if err != nil {
+			return nil, err
+		}
+		indices[i] = int(j)
+	}
+	return indices, nil
+}
@@ -0,0 +1,155 @@
+package random
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomSampleHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		items   []string
+		k       int
+		replace bool
+		wantErr bool
+	}{
+		{
+			desc:    "invalid request with missing items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"k": 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero k",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a", "b"}, "k": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with k greater than items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a", "b"}, "k": 3}}},
+			wantErr: true,
+		},
+		{
+			desc:    "valid request with k less than items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a", "b", "c", "d", "e"}, "k": 2}}},
+			items:   []string{"a", "b", "c", "d", "e"},
+			k:       2,
+		},
+		{
+			desc:    "valid request with k equal to items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a", "b", "c", "d"}, "k": 4}}},
+			items:   []string{"a", "b", "c", "d"},
+			k:       4,
+		},
+		{
+			desc:    "valid request with duplicate items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"x", "x", "x"}, "k": 3}}},
+			items:   []string{"x", "x", "x"},
+			k:       3,
+		},
+		{
+			desc:    "valid request with replacement and k greater than items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a", "b", "c"}, "k": 10, "replace": true}}},
+			items:   []string{"a", "b", "c"},
+			k:       10,
+			replace: true,
+		},
+		{
+			desc:    "valid request with replacement and a single item",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"only"}, "k": 3, "replace": true}}},
+			items:   []string{"only"},
+			k:       3,
+			replace: true,
+		},
+		{
+			desc:    "invalid request with replacement and no items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{}, "k": 1, "replace": true}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with replacement and zero k",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a"}, "k": 0, "replace": true}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with replacement and k over the limit",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a"}, "k": maxSampleReplacementK + 1, "replace": true}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomSampleHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomSampleHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomSampleHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomSampleHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomSampleHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomSampleHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomSampleResponse)
+			if !ok {
+				t.Fatalf("randomSampleHandler() structured content type = %T, want randomSampleResponse", result.StructuredContent)
+			}
+			if len(structured.Values) != tc.k || len(structured.Indices) != tc.k {
+				t.Fatalf("randomSampleHandler() got %d values and %d indices, want %d", len(structured.Values), len(structured.Indices), tc.k)
+			}
+
+			seen := map[int]struct{}{}
+			for i, index := range structured.Indices {
+				if index < 0 || index >= len(tc.items) {
+					t.Fatalf("randomSampleHandler() index out of range: %d", index)
+				}
+				if _, ok := seen[index]; ok && !tc.replace {
+					t.Fatalf("randomSampleHandler() duplicate index %d", index)
+				}
+				seen[index] = struct{}{}
+				if structured.Values[i] != tc.items[index] {
+					t.Fatalf("randomSampleHandler() value %q != item at index %d", structured.Values[i], index)
+				}
+			}
+			if textContent.Text != strings.Join(structured.Values, "\n") {
+				t.Fatalf("randomSampleHandler() text value != joined structured values")
+			}
+		})
+	}
+}
+
+func TestRandomSampleIndicesWithReplacementRepeats(t *testing.T) {
+	// Drawing more indices than there are items must repeat some of them.
+	indices, err := randomSampleIndicesWithReplacement(t.Context(), 3, 10)
+	if err != nil {
+		t.Fatalf("randomSampleIndicesWithReplacement() error = %v", err)
+	}
+	if len(indices) != 10 {
+		t.Fatalf("randomSampleIndicesWithReplacement() returned %d indices, want 10", len(indices))
+	}
+	counts := map[int]int{}
+	for _, index := range indices {
+		if index < 0 || index >= 3 {
+			t.Fatalf("randomSampleIndicesWithReplacement() index out of range: %d", index)
+		}
+		counts[index]++
+	}
+	if len(counts) >= len(indices) {
+		t.Fatalf("randomSampleIndicesWithReplacement() returned no duplicates: %v", indices)
+	}
+}
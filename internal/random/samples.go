@@ -0,0 +1,62 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxSamplesCount caps the number of samples random_samples will return in a single call.
+const maxSamplesCount = 100000
+
+type randomSamplesResponse struct {
+	Distribution  string    `json:"distribution"`
+	Values        []float64 `json:"values"`
+	Count         int       `json:"count"`
+	SchemaVersion int       `json:"schemaVersion"`
+	Algorithm     string    `json:"algorithm"`
+}
+
+// randomSamplesArgs holds the parameters of every supported distribution; only those of the
+// chosen distribution are used, with the same defaults as random_histogram.
+type randomSamplesArgs struct {
+	Distribution string   `json:"distribution"`
+	Count        int      `json:"count"`
+	Min          *float64 `json:"min,omitempty"`
+	Max          *float64 `json:"max,omitempty"`
+	Mean         *float64 `json:"mean,omitempty"`
+	StdDev       *float64 `json:"stddev,omitempty"`
+	Rate         *float64 `json:"rate,omitempty"`
+}
+
+func randomSamplesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomSamplesArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_samples", err), nil
+	}
+	if args.Count <= 0 || args.Count > maxSamplesCount {
+		return toolErrorResult("random_samples", fmt.Errorf("count must be between 1 and %d", maxSamplesCount)), nil
+	}
+
+	params := distributionParams{min: args.Min, max: args.Max, mean: args.Mean, stddev: args.StdDev, rate: args.Rate}
+	values, err := sampleDistribution(ctx, args.Distribution, params, args.Count)
+	if err != nil {
+		return toolErrorResult("random_samples", err), nil
+	}
+
+	lines := make([]string, len(values))
+	for i, value := range values {
+		lines[i] = strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	response := randomSamplesResponse{Distribution: args.Distribution, Values: values, Count: len(values), SchemaVersion: schemaVersions["random_samples"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
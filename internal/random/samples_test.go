@@ -0,0 +1,126 @@
+package random
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomSamplesHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		count   int
+		inRange func(float64) bool
+		wantErr bool
+	}{
+		{
+			desc:    "valid uniform request with defaults",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 50}}},
+			count:   50,
+			inRange: func(v float64) bool { return v >= 0 && v < 1 },
+		},
+		{
+			desc:    "valid uniform request with bounds",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 20, "min": -5.0, "max": 5.0}}},
+			count:   20,
+			inRange: func(v float64) bool { return v >= -5 && v < 5 },
+		},
+		{
+			desc:    "valid normal request",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "normal", "count": 7, "mean": 10.0, "stddev": 0.5}}},
+			count:   7,
+			inRange: func(v float64) bool { return v > 0 && v < 20 },
+		},
+		{
+			desc:    "valid exponential request",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "exponential", "count": 30, "rate": 2.0}}},
+			count:   30,
+			inRange: func(v float64) bool { return v >= 0 },
+		},
+		{
+			desc:    "invalid request with unknown distribution",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "cauchy", "count": 5}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with count above maximum",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": maxSamplesCount + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with inverted uniform bounds",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "uniform", "count": 5, "min": 3.0, "max": 1.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "normal", "count": 5, "stddev": 0.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with non-positive rate",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"distribution": "exponential", "count": 5, "rate": -1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomSamplesHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomSamplesHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomSamplesHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomSamplesHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomSamplesHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomSamplesResponse)
+			if !ok {
+				t.Fatalf("randomSamplesHandler() structured content type = %T, want randomSamplesResponse", result.StructuredContent)
+			}
+			if structured.Count != tc.count || len(structured.Values) != tc.count {
+				t.Fatalf("randomSamplesHandler() count = %d with %d values, want %d", structured.Count, len(structured.Values), tc.count)
+			}
+			if structured.Distribution != tc.request.GetArguments()["distribution"] {
+				t.Fatalf("randomSamplesHandler() distribution = %q, want %q", structured.Distribution, tc.request.GetArguments()["distribution"])
+			}
+			for _, value := range structured.Values {
+				if !tc.inRange(value) {
+					t.Fatalf("randomSamplesHandler() value %g out of range", value)
+				}
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomSamplesHandler() content type = %T, want mcp.TextContent", result.Content[0])
+			}
+			lines := strings.Split(textContent.Text, "\n")
+			if len(lines) != tc.count {
+				t.Fatalf("randomSamplesHandler() text has %d lines, want %d", len(lines), tc.count)
+			}
+			for i, line := range lines {
+				if line != strconv.FormatFloat(structured.Values[i], 'g', -1, 64) {
+					t.Fatalf("randomSamplesHandler() line %d = %q, want %g", i, line, structured.Values[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package random
+
+import "testing"
+
+func TestSchemaVersions(t *testing.T) {
+	// A change here must come with a change to the tool's response shape, and vice versa.
+	want := map[string]int{
+		"random_int":              3,
+		"random_float":            4,
+		"random_ascii":            2,
+		"random_string":           2,
+		"random_bytes":            2,
+		"random_bool":             2,
+		"random_choice":           3,
+		"random_sample":           2,
+		"random_shuffle":          2,
+		"random_gaussian":         2,
+		"random_exponential":      2,
+		"random_password":         2,
+		"random_dice":             2,
+		"random_color":            2,
+		"random_date":             2,
+		"random_normal_int":       2,
+		"random_hex":              2,
+		"random_permutation":      2,
+		"random_prime":            2,
+		"random_mac":              2,
+		"random_ip":               2,
+		"random_word":             2,
+		"random_passphrase":       2,
+		"random_poisson":          2,
+		"random_binomial":         2,
+		"random_jitter":           2,
+		"random_truncated_normal": 2,
+		"random_token":            2,
+		"random_index":            2,
+		"random_weighted_int":     2,
+		"random_histogram":        2,
+		"random_duration":         2,
+		"random_geo":              2,
+		"random_walk":             2,
+		"random_lorem":            2,
+		"random_slug":             2,
+		"random_bivariate_normal": 2,
+		"random_modular":          2,
+		"random_int_stream":       2,
+		"random_card":             2,
+		"random_bell_die":         2,
+		"random_name":             2,
+		"random_email":            2,
+		"random_bigint":           2,
+		"random_samples":          2,
+		"random_dice_pool":        2,
+		"random_map_choice":       1,
+		"normal_quantile":         2,
+		"validate_range":          2,
+	}
+
+	for tool, version := range want {
+		if got := schemaVersions[tool]; got != version {
+			t.Errorf("schemaVersions[%q] = %d, want %d", tool, got, version)
+		}
+	}
+	for tool := range schemaVersions {
+		if _, ok := want[tool]; !ok {
+			t.Errorf("schemaVersions has unexpected tool %q", tool)
+		}
+	}
+
+	for name := range NewMCPServer("test-server", "0.0.0").ListTools() {
+		if _, ok := schemaVersions[name]; !ok {
+			t.Errorf("registered tool %q has no schema version", name)
+		}
+	}
+}
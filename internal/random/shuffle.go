@@ -0,0 +1,57 @@
+package random
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomShuffleResponse struct {
+	Values        []string `json:"values"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Algorithm     string   `json:"algorithm"`
+}
+
+type randomShuffleArgs struct {
+	Items []string `json:"items"`
+}
+
+func randomShuffleHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomShuffleArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_shuffle", err), nil
+	}
+
+	values, err := shuffledCopy(ctx, args.Items)
+	if err != nil {
+		return toolErrorResult("random_shuffle", err), nil
+	}
+
+	response := randomShuffleResponse{Values: values, SchemaVersion: schemaVersions["random_shuffle"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(values, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// shuffledCopy returns a cryptographically secure Fisher-Yates permutation of items.
+// The input slice is never modified; an empty input yields an empty, non-nil slice.
+func shuffledCopy[T any](ctx context.Context, items []T) ([]T, error) {
+	values := make([]T, len(items))
+	copy(values, items)
+	for i := len(values) - 1; i > 0; i-- {
+		if err := checkCancelled(ctx, len(values)-1-i); err != nil {
+			return nil, err
+		}
+		j, err := sourceFromContext(ctx).Int64(0, int64(i))
+		if err != nil {
+			return nil, err
+		}
+		values[i], values[j] = values[j], values[i]
+	}
+
+	return values, nil
+}
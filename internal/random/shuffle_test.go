@@ -0,0 +1,91 @@
+package random
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomShuffleHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		items   []string
+	}{
+		{
+			desc:    "valid request with missing items",
+			request: mcp.CallToolRequest{},
+			items:   []string{},
+		},
+		{
+			desc:    "valid request with empty items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{}}}},
+			items:   []string{},
+		},
+		{
+			desc:    "valid request with single item",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"only"}}}},
+			items:   []string{"only"},
+		},
+		{
+			desc:    "valid request with several items",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []string{"a", "b", "c", "d", "e", "b"}}}},
+			items:   []string{"a", "b", "c", "d", "e", "b"},
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomShuffleHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomShuffleHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomShuffleHandler() result is nil or empty")
+			}
+			if result.IsError {
+				t.Fatalf("randomShuffleHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomShuffleHandler() content type = %T, want TextContent", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomShuffleResponse)
+			if !ok {
+				t.Fatalf("randomShuffleHandler() structured content type = %T, want randomShuffleResponse", result.StructuredContent)
+			}
+			if structured.Values == nil {
+				t.Fatalf("randomShuffleHandler() structured values is nil")
+			}
+
+			got := slices.Clone(structured.Values)
+			want := slices.Clone(tc.items)
+			slices.Sort(got)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Fatalf("randomShuffleHandler() values %v are not a permutation of %v", structured.Values, tc.items)
+			}
+			if textContent.Text != strings.Join(structured.Values, "\n") {
+				t.Fatalf("randomShuffleHandler() text value != joined structured values")
+			}
+		})
+	}
+}
+
+func TestShuffledCopyDoesNotMutateInput(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	original := slices.Clone(items)
+	for i := 0; i < 10; i++ {
+		if _, err := shuffledCopy(t.Context(), items); err != nil {
+			t.Fatalf("shuffledCopy() error = %v", err)
+		}
+	}
+	if !slices.Equal(items, original) {
+		t.Fatalf("shuffledCopy() mutated input: %v", items)
+	}
+}
@@ -0,0 +1,125 @@
+package random
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxSlugParts caps the number of words random_slug will join in a single call.
+const maxSlugParts = 10
+
+// maxSlugSeparatorLength caps the length of the separator placed between slug parts.
+const maxSlugSeparatorLength = 8
+
+// The optional numeric suffix is a zero-padded number of slugSuffixDigits digits, e.g. 4821.
+const (
+	slugSuffixDigits = 4
+	maxSlugSuffix    = 9999
+)
+
+// slugAdjectiveData and slugNounData are short lists of plain, inoffensive English words
+// used to build readable slugs.
+//
+//go:embed slugadjectives.txt
+var slugAdjectiveData string
+
+//go:embed slugnouns.txt
+var slugNounData string
+
+var (
+	slugAdjectives = strings.Fields(slugAdjectiveData)
+	slugNouns      = strings.Fields(slugNounData)
+)
+
+type randomSlugResponse struct {
+	Value         string `json:"value"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomSlugArgs struct {
+	Parts     *int    `json:"parts,omitempty"`
+	Separator *string `json:"separator,omitempty"`
+	Suffix    bool    `json:"suffix,omitempty"`
+}
+
+func randomSlugHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomSlugArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_slug", err), nil
+	}
+
+	parts := 3
+	separator := "-"
+	if args.Parts != nil {
+		parts = *args.Parts
+	}
+	if args.Separator != nil {
+		separator = *args.Separator
+	}
+
+	value, err := randomSlug(ctx, parts, separator, args.Suffix)
+	if err != nil {
+		return toolErrorResult("random_slug", err), nil
+	}
+
+	response := randomSlugResponse{Value: value, SchemaVersion: schemaVersions["random_slug"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomSlug returns parts words joined by separator: parts-1 adjectives followed by a noun,
+// as in "brave-amber-otter". With suffix set, a zero-padded slugSuffixDigits-digit number is
+// appended as a final part. Parts must be between 1 and maxSlugParts, and the separator may
+// only contain URL unreserved characters (letters, digits, "-", ".", "_", "~").
+func randomSlug(ctx context.Context, parts int, separator string, suffix bool) (string, error) {
+	if parts <= 0 || parts > maxSlugParts {
+		return "", fmt.Errorf("parts must be between 1 and %d", maxSlugParts)
+	}
+	if len(separator) > maxSlugSeparatorLength {
+		return "", fmt.Errorf("separator cannot be longer than %d characters", maxSlugSeparatorLength)
+	}
+	for _, r := range separator {
+		if !isURLUnreserved(r) {
+			return "", fmt.Errorf("separator %q must only contain letters, digits, or -._~", separator)
+		}
+	}
+
+	words := make([]string, 0, parts+1)
+	for i := range parts {
+		list := slugAdjectives
+		if i == parts-1 {
+			list = slugNouns
+		}
+		index, err := sourceFromContext(ctx).Int64(0, int64(len(list)-1))
+		if err != nil {
+			return "", err
+		}
+		words = append(words, list[index])
+	}
+	if suffix {
+		number, err := sourceFromContext(ctx).Int64(0, maxSlugSuffix)
+		if err != nil {
+			return "", err
+		}
+		words = append(words, fmt.Sprintf("%0*d", slugSuffixDigits, number))
+	}
+	return strings.Join(words, separator), nil
+}
+
+// isURLUnreserved reports whether r may appear in a URL path segment without escaping.
+func isURLUnreserved(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	}
+	return strings.ContainsRune("-._~", r)
+}
@@ -0,0 +1,114 @@
+package random
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomSlugHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		request   mcp.CallToolRequest
+		parts     int
+		separator string
+		suffix    bool
+		wantErr   bool
+	}{
+		{
+			desc:      "valid request with no args",
+			request:   mcp.CallToolRequest{},
+			parts:     3,
+			separator: "-",
+		},
+		{
+			desc:      "valid request with suffix",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"suffix": true}}},
+			parts:     3,
+			separator: "-",
+			suffix:    true,
+		},
+		{
+			desc:      "valid request with one part and custom separator",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"parts": 1, "separator": "_", "suffix": true}}},
+			parts:     1,
+			separator: "_",
+			suffix:    true,
+		},
+		{
+			desc:      "valid request with many parts",
+			request:   mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"parts": maxSlugParts, "separator": "."}}},
+			parts:     maxSlugParts,
+			separator: ".",
+		},
+		{
+			desc:    "invalid request with zero parts",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"parts": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with too many parts",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"parts": maxSlugParts + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with reserved separator",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"separator": "/"}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomSlugHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomSlugHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomSlugHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomSlugHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomSlugHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomSlugResponse)
+			if !ok {
+				t.Fatalf("randomSlugHandler() structured content type = %T, want randomSlugResponse", result.StructuredContent)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != structured.Value {
+				t.Fatalf("randomSlugHandler() text content = %+v, want %q", result.Content[0], structured.Value)
+			}
+
+			words := strings.Split(structured.Value, tc.separator)
+			if tc.suffix {
+				suffix := words[len(words)-1]
+				words = words[:len(words)-1]
+				if len(suffix) != slugSuffixDigits || strings.Trim(suffix, "0123456789") != "" {
+					t.Fatalf("randomSlugHandler() suffix = %q, want %d digits", suffix, slugSuffixDigits)
+				}
+			}
+			if len(words) != tc.parts {
+				t.Fatalf("randomSlugHandler() value %q has %d parts, want %d", structured.Value, len(words), tc.parts)
+			}
+			for i, word := range words {
+				list := slugAdjectives
+				if i == len(words)-1 {
+					list = slugNouns
+				}
+				if !slices.Contains(list, word) {
+					t.Fatalf("randomSlugHandler() part %d = %q, not in the expected word list", i, word)
+				}
+			}
+		})
+	}
+}
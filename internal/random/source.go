@@ -0,0 +1,28 @@
+package random
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+)
+
+// defaultSource is the entropy source used when a context carries none.
+var defaultSource = securerand.New(rand.Reader)
+
+type sourceKey struct{}
+
+// withSource returns a copy of ctx whose generators draw from src. configMiddleware uses it
+// to apply WithRandReader, and tests use it with a fixed reader to make handler output deterministic.
+func withSource(ctx context.Context, src *securerand.Rand) context.Context {
+	return context.WithValue(ctx, sourceKey{}, src)
+}
+
+// sourceFromContext returns the source attached by withSource, or one backed by
+// crypto/rand.Reader. Generators must draw all their entropy through it.
+func sourceFromContext(ctx context.Context) *securerand.Rand {
+	if src, ok := ctx.Value(sourceKey{}).(*securerand.Rand); ok {
+		return src
+	}
+	return defaultSource
+}
@@ -0,0 +1,127 @@
+package random
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/kevensen/go-random-number-mcp/pkg/securerand"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSourceFromContext(t *testing.T) {
+	if got := sourceFromContext(t.Context()); got != defaultSource {
+		t.Fatalf("sourceFromContext() without source = %p, want defaultSource %p", got, defaultSource)
+	}
+
+	src := securerand.New(bytes.NewReader(nil))
+	if got := sourceFromContext(withSource(t.Context(), src)); got != src {
+		t.Fatalf("sourceFromContext() = %p, want %p", got, src)
+	}
+}
+
+// TestRandomIntHandlerExclusivityKnownValues pins the exclusivity math to exact outputs.
+// crypto/rand.Int reads one byte for ranges of up to 256 values, masks it to the bit length
+// of size-1, and rejects results that are not below size.
+func TestRandomIntHandlerExclusivityKnownValues(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		args    map[string]any
+		entropy []byte
+		want    int64
+	}{
+		{
+			desc:    "exclusive min shifts the offset",
+			args:    map[string]any{"min": int64(5), "max": int64(9), "includeMin": false},
+			entropy: []byte{0x02},
+			want:    8,
+		},
+		{
+			desc:    "exclusive max removes the top value",
+			args:    map[string]any{"min": int64(5), "max": int64(9), "includeMax": false},
+			entropy: []byte{0x03},
+			want:    8,
+		},
+		{
+			desc:    "both exclusive rejects offsets past the range",
+			args:    map[string]any{"min": int64(5), "max": int64(9), "includeMin": false, "includeMax": false},
+			entropy: []byte{0x03, 0x00},
+			want:    6,
+		},
+		{
+			desc:    "exclusive bounds on a negative range",
+			args:    map[string]any{"min": int64(-3), "max": int64(3), "includeMin": false, "includeMax": false},
+			entropy: []byte{0xff, 0x04},
+			want:    2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := withSource(t.Context(), securerand.New(bytes.NewReader(tc.entropy)))
+			result, err := randomIntHandler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatalf("randomIntHandler() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("randomIntHandler() returned error content: %+v", result.Content[0])
+			}
+			if got := result.StructuredContent.(randomIntResponse).Value; got != tc.want {
+				t.Fatalf("randomIntHandler() value = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHandlersAreDeterministicWithFixedSource(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		handler func(t *testing.T, src *securerand.Rand) any
+	}{
+		{
+			desc: "random_int",
+			handler: func(t *testing.T, src *securerand.Rand) any {
+				request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": int64(1), "max": int64(1000), "count": 10}}}
+				result, err := randomIntHandler(withSource(t.Context(), src), request)
+				if err != nil {
+					t.Fatalf("randomIntHandler() error = %v", err)
+				}
+				return result.StructuredContent
+			},
+		},
+		{
+			desc: "random_gaussian",
+			handler: func(t *testing.T, src *securerand.Rand) any {
+				request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 5}}}
+				result, err := randomGaussianHandler(withSource(t.Context(), src), request)
+				if err != nil {
+					t.Fatalf("randomGaussianHandler() error = %v", err)
+				}
+				return result.StructuredContent
+			},
+		},
+		{
+			desc: "random_shuffle",
+			handler: func(t *testing.T, src *securerand.Rand) any {
+				request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"items": []any{"a", "b", "c", "d", "e"}}}}
+				result, err := randomShuffleHandler(withSource(t.Context(), src), request)
+				if err != nil {
+					t.Fatalf("randomShuffleHandler() error = %v", err)
+				}
+				return result.StructuredContent
+			},
+		},
+	}
+
+	seed := [32]byte{'s', 'e', 'e', 'd'}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			first := tc.handler(t, securerand.New(rand.NewChaCha8(seed)))
+			second := tc.handler(t, securerand.New(rand.NewChaCha8(seed)))
+			if !reflect.DeepEqual(first, second) {
+				t.Fatalf("%s with the same seed returned %+v and %+v", tc.desc, first, second)
+			}
+		})
+	}
+}
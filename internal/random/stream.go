@@ -0,0 +1,153 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Limits on random_int_stream. Values are sent in chunks of at most maxStreamChunkSize, so
+// the server never holds more than one chunk plus whatever the transport has queued.
+const (
+	maxStreamCount         = 10000000
+	defaultStreamChunkSize = 1000
+	maxStreamChunkSize     = 10000
+)
+
+// streamRetryInterval is how long random_int_stream waits before resending a chunk when the
+// session's notification queue is full.
+const streamRetryInterval = 10 * time.Millisecond
+
+// methodProgressNotification is the MCP method used to deliver streamed chunks.
+const methodProgressNotification = "notifications/progress"
+
+// randomIntStreamResponse summarizes a completed stream; the values themselves arrive only in
+// the progress notifications.
+type randomIntStreamResponse struct {
+	Count         int    `json:"count"`
+	Chunks        int    `json:"chunks"`
+	Min           int64  `json:"min"`
+	Max           int64  `json:"max"`
+	SchemaVersion int    `json:"schemaVersion"`
+	Algorithm     string `json:"algorithm"`
+}
+
+type randomIntStreamArgs struct {
+	Min       *int64 `json:"min,omitempty"`
+	Max       *int64 `json:"max,omitempty"`
+	Count     int    `json:"count"`
+	ChunkSize *int   `json:"chunkSize,omitempty"`
+}
+
+func randomIntStreamHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomIntStreamArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_int_stream", err), nil
+	}
+
+	cfg := configFromContext(ctx)
+	min := cfg.defaultIntMin
+	max := cfg.defaultIntMax
+	if args.Min != nil {
+		min = *args.Min
+	}
+	if args.Max != nil {
+		max = *args.Max
+	}
+	chunkSize := defaultStreamChunkSize
+	if args.ChunkSize != nil {
+		chunkSize = *args.ChunkSize
+	}
+	if args.Count <= 0 || args.Count > maxStreamCount {
+		return toolErrorResult("random_int_stream", fmt.Errorf("count must be between 1 and %d", maxStreamCount)), nil
+	}
+	if chunkSize <= 0 || chunkSize > maxStreamChunkSize {
+		return toolErrorResult("random_int_stream", fmt.Errorf("chunkSize must be between 1 and %d", maxStreamChunkSize)), nil
+	}
+
+	var token mcp.ProgressToken
+	if request.Params.Meta != nil {
+		token = request.Params.Meta.ProgressToken
+	}
+	mcpServer := server.ServerFromContext(ctx)
+	if token == nil || mcpServer == nil {
+		return toolErrorResult("random_int_stream", errors.New("a progressToken is required in _meta to receive the streamed values")), nil
+	}
+
+	sent := 0
+	chunks := 0
+	err := streamInts(ctx, min, max, args.Count, chunkSize, func(chunk []int64) error {
+		sent += len(chunk)
+		chunks++
+		params := map[string]any{
+			"progressToken": token,
+			"progress":      float64(sent),
+			"total":         float64(args.Count),
+			"message":       fmt.Sprintf("chunk %d: values %d-%d of %d", chunks, sent-len(chunk)+1, sent, args.Count),
+			// The notification is serialized after it is queued, so it needs its own copy.
+			"values": slices.Clone(chunk),
+		}
+		return sendStreamNotification(ctx, mcpServer, params)
+	})
+	if err != nil {
+		return toolErrorResult("random_int_stream", err), nil
+	}
+
+	response := randomIntStreamResponse{Count: sent, Chunks: chunks, Min: min, Max: max, SchemaVersion: schemaVersions["random_int_stream"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: fmt.Sprintf("streamed %d values in %d chunks", sent, chunks)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// streamInts draws count integers uniformly from [min, max] and passes them to emit in order,
+// chunkSize at a time; the final chunk may be shorter. The chunk slice is reused between calls,
+// so emit must copy any values it keeps. Streaming stops at the first error from emit or ctx.
+func streamInts(ctx context.Context, min, max int64, count, chunkSize int, emit func([]int64) error) error {
+	if min > max {
+		return &RangeError{}
+	}
+
+	chunk := make([]int64, 0, chunkSize)
+	for i := range count {
+		if err := checkCancelled(ctx, i); err != nil {
+			return err
+		}
+		value, err := sourceFromContext(ctx).Int64(min, max)
+		if err != nil {
+			return err
+		}
+		chunk = append(chunk, value)
+		if len(chunk) == chunkSize || i == count-1 {
+			if err := emit(chunk); err != nil {
+				return err
+			}
+			chunk = chunk[:0]
+		}
+	}
+	return nil
+}
+
+// sendStreamNotification sends a progress notification to the calling client. The session
+// queue is bounded and drops rather than blocks, so a full queue is retried until it drains
+// or ctx is done; this is what keeps a fast generator from outrunning a slow client.
+func sendStreamNotification(ctx context.Context, mcpServer *server.MCPServer, params map[string]any) error {
+	for {
+		err := mcpServer.SendNotificationToClient(ctx, methodProgressNotification, params)
+		if !errors.Is(err, server.ErrNotificationChannelBlocked) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(streamRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package random
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// streamTestSession is an initialized client session whose notifications are read by the test.
+type streamTestSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *streamTestSession) Initialize()       {}
+func (s *streamTestSession) Initialized() bool { return true }
+func (s *streamTestSession) SessionID() string { return "stream-test" }
+func (s *streamTestSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+
+func TestRandomIntStreamHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+	}{
+		{
+			desc:    "invalid request without progress token",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 10}}},
+		},
+		{
+			desc:    "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 0}}},
+		},
+		{
+			desc:    "invalid request with count over the limit",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": maxStreamCount + 1}}},
+		},
+		{
+			desc:    "invalid request with chunk size over the limit",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 10, "chunkSize": maxStreamChunkSize + 1}}},
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomIntStreamHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomIntStreamHandler() error = %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Fatalf("randomIntStreamHandler() expected error, got %+v", result)
+			}
+		})
+	}
+}
+
+func TestRandomIntStreamSendsChunks(t *testing.T) {
+	const (
+		count     = 2500
+		chunkSize = 100
+	)
+	mcpServer := NewMCPServer("test-server", "0.0.0")
+	// A queue much shorter than the number of chunks makes the handler wait for the reader.
+	session := &streamTestSession{notifications: make(chan mcp.JSONRPCNotification, 4)}
+	if err := mcpServer.RegisterSession(t.Context(), session); err != nil {
+		t.Fatalf("RegisterSession() error = %v", err)
+	}
+	ctx := mcpServer.WithContext(t.Context(), session)
+
+	type chunk struct {
+		values   int
+		progress float64
+		inRange  bool
+	}
+	received := make(chan []chunk)
+	go func() {
+		var chunks []chunk
+		for notification := range session.notifications {
+			params := notification.Params.AdditionalFields
+			values, _ := params["values"].([]int64)
+			inRange := true
+			for _, value := range values {
+				inRange = inRange && value >= -5 && value <= 5
+			}
+			progress, _ := params["progress"].(float64)
+			chunks = append(chunks, chunk{values: len(values), progress: progress, inRange: inRange})
+		}
+		received <- chunks
+	}()
+
+	message := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_int_stream","arguments":{"min":-5,"max":5,"count":%d,"chunkSize":%d},"_meta":{"progressToken":"stream-1"}}}`, count, chunkSize)
+	response := mcpServer.HandleMessage(ctx, json.RawMessage(message))
+	close(session.notifications)
+	chunks := <-received
+
+	rpcResponse, ok := response.(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("HandleMessage() response type = %T, want JSONRPCResponse", response)
+	}
+	result, ok := rpcResponse.Result.(mcp.CallToolResult)
+	if !ok || result.IsError {
+		t.Fatalf("HandleMessage() result = %+v, want success", rpcResponse.Result)
+	}
+	audited, ok := result.StructuredContent.(auditedResponse)
+	if !ok {
+		t.Fatalf("random_int_stream structured content type = %T, want auditedResponse", result.StructuredContent)
+	}
+	structured, ok := audited.response.(randomIntStreamResponse)
+	if !ok {
+		t.Fatalf("random_int_stream audited response type = %T, want randomIntStreamResponse", audited.response)
+	}
+	if structured.Count != count || structured.Chunks != count/chunkSize {
+		t.Fatalf("random_int_stream summary = %+v, want %d values in %d chunks", structured, count, count/chunkSize)
+	}
+
+	if len(chunks) != count/chunkSize {
+		t.Fatalf("received %d notifications, want %d", len(chunks), count/chunkSize)
+	}
+	total := 0
+	for i, c := range chunks {
+		total += c.values
+		if c.values != chunkSize || !c.inRange {
+			t.Fatalf("notification %d = %+v, want %d values in [-5, 5]", i, c, chunkSize)
+		}
+		if c.progress != float64(total) {
+			t.Fatalf("notification %d progress = %g, want %d", i, c.progress, total)
+		}
+	}
+}
+
+func TestStreamIntsHoldsOneChunk(t *testing.T) {
+	// A large stream must be produced through a single reused chunk rather than a slice of
+	// every value; the first element of every emitted chunk shares one backing array.
+	const (
+		count     = 200003
+		chunkSize = 1000
+	)
+	var first *int64
+	emitted := 0
+	chunks := 0
+	err := streamInts(t.Context(), math.MinInt64, math.MaxInt64, count, chunkSize, func(chunk []int64) error {
+		if len(chunk) == 0 || len(chunk) > chunkSize || cap(chunk) != chunkSize {
+			t.Fatalf("streamInts() emitted chunk of len %d, cap %d, want at most %d", len(chunk), cap(chunk), chunkSize)
+		}
+		if first == nil {
+			first = &chunk[0]
+		} else if &chunk[0] != first {
+			t.Fatalf("streamInts() emitted chunk %d in a new allocation", chunks)
+		}
+		emitted += len(chunk)
+		chunks++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("streamInts() error = %v", err)
+	}
+	if emitted != count {
+		t.Fatalf("streamInts() emitted %d values, want %d", emitted, count)
+	}
+	if want := count/chunkSize + 1; chunks != want {
+		t.Fatalf("streamInts() emitted %d chunks, want %d", chunks, want)
+	}
+}
+
+func TestStreamIntsRejectsInvertedRange(t *testing.T) {
+	err := streamInts(t.Context(), 5, 1, 10, 10, func([]int64) error { return nil })
+	if _, ok := err.(*RangeError); !ok {
+		t.Fatalf("streamInts() error = %v, want *RangeError", err)
+	}
+}
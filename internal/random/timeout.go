@@ -0,0 +1,44 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// timeoutMiddleware bounds every tool call to timeout. The handler runs with a context that
+// expires at the deadline, so generators that check for cancellation stop promptly; a handler
+// that does not is abandoned and the caller gets a DEADLINE_EXCEEDED error result instead of a
+// hung request. A zero timeout disables the limit.
+func timeoutMiddleware(timeout time.Duration) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if timeout <= 0 {
+			return next
+		}
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			type outcome struct {
+				result *mcp.CallToolResult
+				err    error
+			}
+			// Buffered so an abandoned handler can still finish and be collected.
+			done := make(chan outcome, 1)
+			go func() {
+				result, err := next(ctx, request)
+				done <- outcome{result: result, err: err}
+			}()
+
+			select {
+			case out := <-done:
+				return out.result, out.err
+			case <-ctx.Done():
+				return toolErrorResult(request.Params.Name, fmt.Errorf("exceeded the %s handler timeout: %w", timeout, ctx.Err())), nil
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package random
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestTimeoutMiddleware(t *testing.T) {
+	// slowHandler ignores its context and blocks until the test ends, like a handler stuck in a
+	// loop that never checks for cancellation.
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	slowHandler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		<-release
+		return mcp.NewToolResultText("too late"), nil
+	}
+	fastHandler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return mcp.NewToolResultError("no deadline"), nil
+		}
+		return mcp.NewToolResultText("done"), nil
+	}
+
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "random_slow"}}
+
+	t.Run("slow handler fails at the deadline", func(t *testing.T) {
+		start := time.Now()
+		result, err := timeoutMiddleware(20*time.Millisecond)(slowHandler)(t.Context(), request)
+		if err != nil {
+			t.Fatalf("timeoutMiddleware() error = %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Fatalf("timeoutMiddleware() returned after %s, want about 20ms", elapsed)
+		}
+		if result == nil || !result.IsError {
+			t.Fatalf("timeoutMiddleware() result = %+v, want error result", result)
+		}
+		structured, ok := result.StructuredContent.(randomErrorResponse)
+		if !ok || structured.Code != errorCodeDeadlineExceeded {
+			t.Fatalf("timeoutMiddleware() structured content = %+v, want code %q", result.StructuredContent, errorCodeDeadlineExceeded)
+		}
+	})
+
+	t.Run("fast handler runs with a deadline", func(t *testing.T) {
+		result, err := timeoutMiddleware(time.Minute)(fastHandler)(t.Context(), request)
+		if err != nil {
+			t.Fatalf("timeoutMiddleware() error = %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("timeoutMiddleware() result = %+v, want success", result)
+		}
+	})
+
+	t.Run("zero timeout disables the limit", func(t *testing.T) {
+		result, err := timeoutMiddleware(0)(fastHandler)(t.Context(), request)
+		if err != nil {
+			t.Fatalf("timeoutMiddleware() error = %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Fatalf("timeoutMiddleware(0) result = %+v, want the handler to see no deadline", result)
+		}
+	})
+}
+
+func TestNewMCPServerAppliesHandlerTimeout(t *testing.T) {
+	// A timeout that has already passed fails even a fast tool call.
+	mcpServer := NewMCPServer("test-server", "0.0.0", WithHandlerTimeout(time.Nanosecond))
+	response := mcpServer.HandleMessage(t.Context(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"random_permutation","arguments":{"n":100000}}}`))
+	rpcResponse, ok := response.(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("HandleMessage() response type = %T, want JSONRPCResponse", response)
+	}
+	result, ok := rpcResponse.Result.(mcp.CallToolResult)
+	if !ok || !result.IsError {
+		t.Fatalf("HandleMessage() result = %+v, want error result", rpcResponse.Result)
+	}
+	structured, ok := result.StructuredContent.(randomErrorResponse)
+	if !ok || structured.Code != errorCodeDeadlineExceeded {
+		t.Fatalf("HandleMessage() structured content = %+v, want code %q", result.StructuredContent, errorCodeDeadlineExceeded)
+	}
+}
+
+func TestWithHandlerTimeout(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{desc: "positive timeout", timeout: time.Second, want: time.Second},
+		{desc: "zero disables the limit", timeout: 0, want: 0},
+		{desc: "negative keeps default", timeout: -time.Second, want: defaultHandlerTimeout},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := defaultConfig()
+			WithHandlerTimeout(tc.timeout)(&cfg)
+			if cfg.handlerTimeout != tc.want {
+				t.Fatalf("WithHandlerTimeout(%s) timeout = %s, want %s", tc.timeout, cfg.handlerTimeout, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package random
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+
+	"github.com/kevensen/go-random-number-mcp/internal/bufpool"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxTokenBytes caps the number of random bytes random_token will encode in a single call.
+const maxTokenBytes = 1024
+
+type randomTokenResponse struct {
+	Value         string  `json:"value"`
+	Bytes         int     `json:"bytes"`
+	EntropyBits   float64 `json:"entropyBits"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+// randomTokenArgs defaults to URL-safe, unpadded base64 so tokens can be used in URLs,
+// cookies, and headers without further escaping.
+type randomTokenArgs struct {
+	Bytes   int   `json:"bytes"`
+	URLSafe *bool `json:"urlSafe,omitempty"`
+	Padding *bool `json:"padding,omitempty"`
+}
+
+func randomTokenHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomTokenArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_token", err), nil
+	}
+
+	urlSafe := true
+	padding := false
+	if args.URLSafe != nil {
+		urlSafe = *args.URLSafe
+	}
+	if args.Padding != nil {
+		padding = *args.Padding
+	}
+
+	value, err := randomToken(ctx, args.Bytes, tokenEncoding(urlSafe, padding))
+	if err != nil {
+		return toolErrorResult("random_token", err), nil
+	}
+	slog.InfoContext(ctx, "randomTokenHandler", slog.Int("bytes", args.Bytes), slog.Bool("urlSafe", urlSafe), slog.Bool("padding", padding), resultAttr("random_token", value))
+
+	response := randomTokenResponse{Value: value, Bytes: args.Bytes, EntropyBits: entropyBits(args.Bytes, 256), SchemaVersion: schemaVersions["random_token"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: value},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// tokenEncoding returns the base64 encoding for the given alphabet and padding choice.
+func tokenEncoding(urlSafe, padding bool) *base64.Encoding {
+	switch {
+	case urlSafe && padding:
+		return base64.URLEncoding
+	case urlSafe:
+		return base64.RawURLEncoding
+	case padding:
+		return base64.StdEncoding
+	default:
+		return base64.RawStdEncoding
+	}
+}
+
+// randomToken returns n cryptographically secure random bytes encoded with enc.
+// N must be greater than zero and no more than maxTokenBytes.
+func randomToken(ctx context.Context, n int, enc *base64.Encoding) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("bytes must be greater than zero")
+	}
+	if n > maxTokenBytes {
+		return "", fmt.Errorf("bytes cannot exceed %d", maxTokenBytes)
+	}
+
+	buf := bufpool.Get(n)
+	defer bufpool.Put(buf)
+	if _, err := sourceFromContext(ctx).Read(*buf); err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(*buf), nil
+}
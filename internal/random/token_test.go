@@ -0,0 +1,114 @@
+package random
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomTokenHandler(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		request  mcp.CallToolRequest
+		bytes    int
+		encoding *base64.Encoding
+		wantErr  bool
+	}{
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative bytes",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": -1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with bytes over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": maxTokenBytes + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:     "valid request with defaults",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 32}}},
+			bytes:    32,
+			encoding: base64.RawURLEncoding,
+		},
+		{
+			desc:     "valid request with url-safe padding",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 16, "padding": true}}},
+			bytes:    16,
+			encoding: base64.URLEncoding,
+		},
+		{
+			desc:     "valid request with standard alphabet",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": 31, "urlSafe": false}}},
+			bytes:    31,
+			encoding: base64.RawStdEncoding,
+		},
+		{
+			desc:     "valid request with standard alphabet and padding",
+			request:  mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"bytes": maxTokenBytes, "urlSafe": false, "padding": true}}},
+			bytes:    maxTokenBytes,
+			encoding: base64.StdEncoding,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomTokenHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomTokenHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomTokenHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomTokenHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomTokenHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomTokenHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			if len(textContent.Text) != tc.encoding.EncodedLen(tc.bytes) {
+				t.Fatalf("randomTokenHandler() token length = %d, want %d", len(textContent.Text), tc.encoding.EncodedLen(tc.bytes))
+			}
+			decoded, err := tc.encoding.DecodeString(textContent.Text)
+			if err != nil {
+				t.Fatalf("randomTokenHandler() token %q does not decode: %v", textContent.Text, err)
+			}
+			if len(decoded) != tc.bytes {
+				t.Fatalf("randomTokenHandler() decoded %d bytes, want %d", len(decoded), tc.bytes)
+			}
+
+			structured, ok := result.StructuredContent.(randomTokenResponse)
+			if !ok {
+				t.Fatalf("randomTokenHandler() structured content type = %T, want randomTokenResponse", result.StructuredContent)
+			}
+			if structured.Value != textContent.Text || structured.Bytes != tc.bytes {
+				t.Fatalf("randomTokenHandler() structured = %+v, want value %q and bytes %d", structured, textContent.Text, tc.bytes)
+			}
+			if structured.EntropyBits != float64(8*tc.bytes) {
+				t.Fatalf("randomTokenHandler() entropyBits = %g, want %d", structured.EntropyBits, 8*tc.bytes)
+			}
+		})
+	}
+}
+
+func TestRandomTokenRejectsZeroBytes(t *testing.T) {
+	_, err := randomToken(t.Context(), 0, base64.RawURLEncoding)
+	if err == nil || !strings.Contains(err.Error(), "bytes") {
+		t.Fatalf("randomToken() error = %v, want one naming bytes", err)
+	}
+}
@@ -0,0 +1,90 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxTruncatedNormalDraws bounds the rejection sampling in random_truncated_normal. An interval
+// that rejects this many draws holds too little probability mass to sample this way.
+const maxTruncatedNormalDraws = 10000
+
+type randomTruncatedNormalResponse struct {
+	Value         float64 `json:"value"`
+	Mean          float64 `json:"mean"`
+	StdDev        float64 `json:"stddev"`
+	Resamples     int     `json:"resamples"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomTruncatedNormalArgs struct {
+	Mean   *float64 `json:"mean,omitempty"`
+	StdDev *float64 `json:"stddev,omitempty"`
+	Min    float64  `json:"min"`
+	Max    float64  `json:"max"`
+}
+
+func randomTruncatedNormalHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomTruncatedNormalArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_truncated_normal", err), nil
+	}
+
+	mean := 0.0
+	stddev := 1.0
+	if args.Mean != nil {
+		mean = *args.Mean
+	}
+	if args.StdDev != nil {
+		stddev = *args.StdDev
+	}
+
+	value, resamples, err := randomTruncatedNormal(ctx, mean, stddev, args.Min, args.Max)
+	if err != nil {
+		return toolErrorResult("random_truncated_normal", err), nil
+	}
+
+	response := randomTruncatedNormalResponse{Value: value, Mean: mean, StdDev: stddev, Resamples: resamples, SchemaVersion: schemaVersions["random_truncated_normal"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: fmt.Sprintf("%g", value)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomTruncatedNormal draws from the normal distribution with the given mean and stddev
+// conditioned on [min, max]. Draws outside the interval are rejected and redrawn rather than
+// clamped, so no probability mass piles up at the bounds. It also returns the number of
+// rejected draws, and fails once maxTruncatedNormalDraws draws have all been rejected.
+func randomTruncatedNormal(ctx context.Context, mean, stddev, min, max float64) (float64, int, error) {
+	if err := validateGaussianParams(mean, stddev); err != nil {
+		return 0, 0, err
+	}
+	for _, bound := range []float64{min, max} {
+		if math.IsNaN(bound) || math.IsInf(bound, 0) {
+			return 0, 0, &NonFiniteError{Value: bound}
+		}
+	}
+	if min >= max {
+		return 0, 0, fmt.Errorf("min must be less than max")
+	}
+
+	for draws := 0; draws < maxTruncatedNormalDraws; draws += 2 {
+		z0, z1, err := standardNormalPair(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		if value := mean + stddev*z0; value >= min && value <= max {
+			return value, draws, nil
+		}
+		if value := mean + stddev*z1; value >= min && value <= max {
+			return value, draws + 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("[%g, %g] is too improbable under mean %g and stddev %g: no sample landed in range after %d draws", min, max, mean, stddev, maxTruncatedNormalDraws)
+}
@@ -0,0 +1,127 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomTruncatedNormalHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		min     float64
+		max     float64
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with default mean and stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": -1.0, "max": 1.0}}},
+			min:     -1,
+			max:     1,
+		},
+		{
+			desc:    "valid request with one-sided tail interval",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"mean": 100.0, "stddev": 15.0, "min": 100.0, "max": 200.0}}},
+			min:     100,
+			max:     200,
+		},
+		{
+			desc:    "invalid request with min equal to max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": 1.0, "max": 1.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with min greater than max",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": 2.0, "max": 1.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero stddev",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"stddev": 0.0, "min": -1.0, "max": 1.0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with improbable interval",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"min": 50.0, "max": 51.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomTruncatedNormalHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomTruncatedNormalHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomTruncatedNormalHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomTruncatedNormalHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomTruncatedNormalHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomTruncatedNormalHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			valueFromText, err := strconv.ParseFloat(textContent.Text, 64)
+			if err != nil {
+				t.Fatalf("randomTruncatedNormalHandler() invalid text content: %v", err)
+			}
+			if valueFromText < tc.min || valueFromText > tc.max {
+				t.Fatalf("randomTruncatedNormalHandler() value %g out of range [%g, %g]", valueFromText, tc.min, tc.max)
+			}
+
+			structured, ok := result.StructuredContent.(randomTruncatedNormalResponse)
+			if !ok {
+				t.Fatalf("randomTruncatedNormalHandler() structured content type = %T, want randomTruncatedNormalResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomTruncatedNormalHandler() structured value %g != text value %g", structured.Value, valueFromText)
+			}
+			if structured.Resamples < 0 || structured.Resamples >= maxTruncatedNormalDraws {
+				t.Fatalf("randomTruncatedNormalHandler() resamples = %d, want [0, %d)", structured.Resamples, maxTruncatedNormalDraws)
+			}
+		})
+	}
+}
+
+func TestRandomTruncatedNormalDoesNotClamp(t *testing.T) {
+	// Clamping a standard normal to [0, 0.1] would put roughly 95% of the samples on the
+	// upper bound; resampling keeps the distribution continuous inside the interval.
+	const samples = 1000
+	atBound := 0
+	for range samples {
+		value, _, err := randomTruncatedNormal(t.Context(), 0, 1, 0, 0.1)
+		if err != nil {
+			t.Fatalf("randomTruncatedNormal() error = %v", err)
+		}
+		if value == 0 || value == 0.1 {
+			atBound++
+		}
+	}
+	if atBound > 1 {
+		t.Fatalf("randomTruncatedNormal() returned a bound %d times in %d samples", atBound, samples)
+	}
+}
+
+func TestRandomTruncatedNormalRejectsNonFinite(t *testing.T) {
+	for _, bound := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, _, err := randomTruncatedNormal(t.Context(), 0, 1, bound, 1); err == nil {
+			t.Fatalf("randomTruncatedNormal(min=%v) expected error, got nil", bound)
+		}
+		if _, _, err := randomTruncatedNormal(t.Context(), 0, 1, -1, bound); err == nil {
+			t.Fatalf("randomTruncatedNormal(max=%v) expected error, got nil", bound)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// validateRangeResponse reports whether random_int could produce a value for a range and, if
+// so, the inclusive bounds it would draw from. CountString holds the number of possible
+// values in decimal, since the full int64 span has 2^64 of them.
+type validateRangeResponse struct {
+	Valid         bool   `json:"valid"`
+	Reason        string `json:"reason,omitempty"`
+	EffectiveMin  int64  `json:"effectiveMin"`
+	EffectiveMax  int64  `json:"effectiveMax"`
+	CountString   string `json:"count"`
+	SchemaVersion int    `json:"schemaVersion"`
+}
+
+type validateRangeArgs struct {
+	Min        *int64 `json:"min,omitempty"`
+	Max        *int64 `json:"max,omitempty"`
+	IncludeMin *bool  `json:"includeMin,omitempty"`
+	IncludeMax *bool  `json:"includeMax,omitempty"`
+}
+
+func validateRangeHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args validateRangeArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("validate_range", err), nil
+	}
+
+	cfg := configFromContext(ctx)
+	min := cfg.defaultIntMin
+	max := cfg.defaultIntMax
+	includeMin := true
+	includeMax := true
+	if args.Min != nil {
+		min = *args.Min
+	}
+	if args.Max != nil {
+		max = *args.Max
+	}
+	if args.IncludeMin != nil {
+		includeMin = *args.IncludeMin
+	}
+	if args.IncludeMax != nil {
+		includeMax = *args.IncludeMax
+	}
+
+	response := validateRange(min, max, args.Min != nil, args.Max != nil, includeMin, includeMax)
+	response.SchemaVersion = schemaVersions["validate_range"]
+
+	text := fmt.Sprintf("valid: %s values in [%d, %d]", response.CountString, response.EffectiveMin, response.EffectiveMax)
+	if !response.Valid {
+		text = "invalid: " + response.Reason
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: text},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// validateRange checks a random_int range without drawing from it. It applies the same
+// defaults and exclusivity rules as random_int, so a range reported valid here is one that
+// random_int (without step or exclude) accepts.
+func validateRange(min, max int64, minProvided, maxProvided, includeMin, includeMax bool) validateRangeResponse {
+	if min > max {
+		return validateRangeResponse{Reason: (&RangeError{}).Error(), CountString: "0"}
+	}
+	adjustedMin, adjustedMax, err := effectiveIntBounds(min, max, minProvided, maxProvided, includeMin, includeMax)
+	if err != nil {
+		return validateRangeResponse{Reason: err.Error(), CountString: "0"}
+	}
+
+	count := new(big.Int).Sub(big.NewInt(adjustedMax), big.NewInt(adjustedMin))
+	count.Add(count, big.NewInt(1))
+	return validateRangeResponse{
+		Valid:        true,
+		EffectiveMin: adjustedMin,
+		EffectiveMax: adjustedMax,
+		CountString:  count.String(),
+	}
+}
@@ -0,0 +1,123 @@
+package random
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestValidateRangeHandler(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		args      map[string]any
+		want      validateRangeResponse
+		wantError bool
+	}{
+		{
+			desc: "defaults cover zero to MaxInt64",
+			want: validateRangeResponse{Valid: true, EffectiveMin: 0, EffectiveMax: math.MaxInt64, CountString: "9223372036854775808"},
+		},
+		{
+			desc: "inclusive range",
+			args: map[string]any{"min": 1, "max": 6},
+			want: validateRangeResponse{Valid: true, EffectiveMin: 1, EffectiveMax: 6, CountString: "6"},
+		},
+		{
+			desc: "exclusive bounds",
+			args: map[string]any{"min": 1, "max": 6, "includeMin": false, "includeMax": false},
+			want: validateRangeResponse{Valid: true, EffectiveMin: 2, EffectiveMax: 5, CountString: "4"},
+		},
+		{
+			desc: "exclusivity ignored for defaulted bounds",
+			args: map[string]any{"max": 10, "includeMin": false},
+			want: validateRangeResponse{Valid: true, EffectiveMin: 0, EffectiveMax: 10, CountString: "11"},
+		},
+		{
+			desc: "full int64 span exceeds int64",
+			args: map[string]any{"min": int64(math.MinInt64), "max": int64(math.MaxInt64)},
+			want: validateRangeResponse{Valid: true, EffectiveMin: math.MinInt64, EffectiveMax: math.MaxInt64, CountString: "18446744073709551616"},
+		},
+		{
+			desc: "min greater than max",
+			args: map[string]any{"min": 9, "max": 2},
+			want: validateRangeResponse{Reason: (&RangeError{}).Error(), CountString: "0"},
+		},
+		{
+			desc: "exclusivity empties the range",
+			args: map[string]any{"min": 5, "max": 5, "includeMin": false},
+			want: validateRangeResponse{Reason: errExclusivityEmptiesRange.Error(), CountString: "0"},
+		},
+		{
+			desc: "excluded MaxInt64 min",
+			args: map[string]any{"min": int64(math.MaxInt64), "includeMin": false},
+			want: validateRangeResponse{Reason: "min cannot be excluded when min is MaxInt64", CountString: "0"},
+		},
+		{
+			desc:      "malformed arguments",
+			args:      map[string]any{"min": "low"},
+			wantError: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			result, err := validateRangeHandler(ctx, request)
+			if err != nil {
+				t.Fatalf("validateRangeHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("validateRangeHandler() result is nil or empty")
+			}
+			if tc.wantError {
+				if !result.IsError {
+					t.Fatalf("validateRangeHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("validateRangeHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(validateRangeResponse)
+			if !ok {
+				t.Fatalf("validateRangeHandler() structured content type = %T, want validateRangeResponse", result.StructuredContent)
+			}
+			tc.want.SchemaVersion = schemaVersions["validate_range"]
+			if structured != tc.want {
+				t.Fatalf("validateRangeHandler() = %+v, want %+v", structured, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateRangeAgreesWithRandomInt(t *testing.T) {
+	// Every range validate_range accepts, random_int must accept too, and vice versa.
+	ranges := []map[string]any{
+		{"min": 1, "max": 6},
+		{"min": 3, "max": 3},
+		{"min": 3, "max": 3, "includeMax": false},
+		{"min": 4, "max": 5, "includeMin": false, "includeMax": false},
+		{"min": 9, "max": 2},
+		{"min": int64(math.MinInt64), "max": int64(math.MinInt64), "includeMax": false},
+	}
+
+	ctx := t.Context()
+	for _, args := range ranges {
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+		validated, err := validateRangeHandler(ctx, request)
+		if err != nil {
+			t.Fatalf("validateRangeHandler(%v) error = %v", args, err)
+		}
+		generated, err := randomIntHandler(ctx, request)
+		if err != nil {
+			t.Fatalf("randomIntHandler(%v) error = %v", args, err)
+		}
+		valid := validated.StructuredContent.(validateRangeResponse).Valid
+		if valid == generated.IsError {
+			t.Fatalf("validate_range(%v) valid = %v, but random_int isError = %v", args, valid, generated.IsError)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxWalkSteps caps the number of steps random_walk will generate in a single call.
+const maxWalkSteps = 100000
+
+type randomWalkResponse struct {
+	Values        []float64 `json:"values"`
+	Steps         int       `json:"steps"`
+	SchemaVersion int       `json:"schemaVersion"`
+	Algorithm     string    `json:"algorithm"`
+}
+
+type randomWalkArgs struct {
+	Steps      int      `json:"steps"`
+	Start      *float64 `json:"start,omitempty"`
+	Drift      *float64 `json:"drift,omitempty"`
+	Volatility *float64 `json:"volatility,omitempty"`
+}
+
+func randomWalkHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomWalkArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_walk", err), nil
+	}
+
+	start := 0.0
+	drift := 0.0
+	volatility := 1.0
+	if args.Start != nil {
+		start = *args.Start
+	}
+	if args.Drift != nil {
+		drift = *args.Drift
+	}
+	if args.Volatility != nil {
+		volatility = *args.Volatility
+	}
+
+	values, err := randomWalk(ctx, start, drift, volatility, args.Steps)
+	if err != nil {
+		return toolErrorResult("random_walk", err), nil
+	}
+
+	lines := make([]string, len(values))
+	for i, value := range values {
+		lines[i] = strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	response := randomWalkResponse{Values: values, Steps: len(values), SchemaVersion: schemaVersions["random_walk"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(lines, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomWalk returns the positions of a Gaussian random walk after each of steps steps. The walk
+// begins at start and every step adds an independent normal increment with mean drift and
+// standard deviation volatility, so the first value is start plus one increment. A volatility of
+// zero yields the deterministic line start + drift*i.
+func randomWalk(ctx context.Context, start, drift, volatility float64, steps int) ([]float64, error) {
+	if steps <= 0 || steps > maxWalkSteps {
+		return nil, fmt.Errorf("steps must be between 1 and %d", maxWalkSteps)
+	}
+	if err := requireFinite("start", start); err != nil {
+		return nil, err
+	}
+	if err := requireFinite("drift", drift); err != nil {
+		return nil, err
+	}
+	if err := requireFinite("volatility", volatility); err != nil {
+		return nil, err
+	}
+	if volatility < 0 {
+		return nil, fmt.Errorf("volatility cannot be negative")
+	}
+
+	increments := make([]float64, steps)
+	if volatility > 0 {
+		var err error
+		increments, err = randomGaussians(ctx, drift, volatility, steps)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for i := range increments {
+			increments[i] = drift
+		}
+	}
+
+	values := make([]float64, steps)
+	position := start
+	for i, increment := range increments {
+		position += increment
+		if math.IsInf(position, 0) {
+			return nil, fmt.Errorf("walk overflows the float64 range after %d steps", i+1)
+		}
+		values[i] = position
+	}
+	return values, nil
+}
@@ -0,0 +1,144 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomWalkHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		steps   int
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with defaults",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"steps": 50}}},
+			steps:   50,
+		},
+		{
+			desc:    "valid request with all parameters",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"steps": 10, "start": 100.0, "drift": 0.5, "volatility": 2.0}}},
+			steps:   10,
+		},
+		{
+			desc:    "valid request with a single step",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"steps": 1}}},
+			steps:   1,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero steps",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"steps": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with too many steps",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"steps": maxWalkSteps + 1}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative volatility",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"steps": 5, "volatility": -1.0}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomWalkHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomWalkHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomWalkHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomWalkHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomWalkHandler() returned error content: %+v", result.Content[0])
+			}
+
+			structured, ok := result.StructuredContent.(randomWalkResponse)
+			if !ok {
+				t.Fatalf("randomWalkHandler() structured content type = %T, want randomWalkResponse", result.StructuredContent)
+			}
+			if structured.Steps != tc.steps || len(structured.Values) != tc.steps {
+				t.Fatalf("randomWalkHandler() steps = %d with %d values, want %d", structured.Steps, len(structured.Values), tc.steps)
+			}
+			lines := make([]string, len(structured.Values))
+			for i, value := range structured.Values {
+				lines[i] = strconv.FormatFloat(value, 'g', -1, 64)
+			}
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok || textContent.Text != strings.Join(lines, "\n") {
+				t.Fatalf("randomWalkHandler() text content = %+v, want one value per line", result.Content[0])
+			}
+		})
+	}
+}
+
+func TestRandomWalkWithoutVolatilityIsALine(t *testing.T) {
+	values, err := randomWalk(t.Context(), 10, 2.5, 0, 4)
+	if err != nil {
+		t.Fatalf("randomWalk() error = %v", err)
+	}
+	want := []float64{12.5, 15, 17.5, 20}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Fatalf("randomWalk() = %v, want %v", values, want)
+		}
+	}
+}
+
+func TestRandomWalkNamesNonFiniteParameter(t *testing.T) {
+	_, err := randomWalk(t.Context(), 0, 0, math.Inf(1), 4)
+	if err == nil || !strings.Contains(err.Error(), "volatility") {
+		t.Fatalf("randomWalk() error = %v, want one naming volatility", err)
+	}
+}
+
+func TestRandomWalkIncrementMoments(t *testing.T) {
+	// Differences between consecutive positions are the increments, so their mean and standard
+	// deviation should match drift and volatility.
+	const (
+		steps      = 20000
+		drift      = 0.5
+		volatility = 2.0
+	)
+	values, err := randomWalk(t.Context(), 0, drift, volatility, steps)
+	if err != nil {
+		t.Fatalf("randomWalk() error = %v", err)
+	}
+
+	previous := 0.0
+	var sum, sumSquares float64
+	for _, value := range values {
+		increment := value - previous
+		previous = value
+		sum += increment
+		sumSquares += increment * increment
+	}
+	mean := sum / steps
+	stddev := math.Sqrt(sumSquares/steps - mean*mean)
+	if math.Abs(mean-drift) > 0.1 {
+		t.Fatalf("randomWalk() mean increment = %.4f, want about %g", mean, drift)
+	}
+	if math.Abs(stddev-volatility) > 0.1 {
+		t.Fatalf("randomWalk() increment stddev = %.4f, want about %g", stddev, volatility)
+	}
+}
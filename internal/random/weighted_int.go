@@ -0,0 +1,68 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type randomWeightedIntResponse struct {
+	Value         int64   `json:"value"`
+	Probability   float64 `json:"probability"`
+	SchemaVersion int     `json:"schemaVersion"`
+	Algorithm     string  `json:"algorithm"`
+}
+
+type randomWeightedIntArgs struct {
+	Values  []int64   `json:"values"`
+	Weights []float64 `json:"weights"`
+}
+
+func randomWeightedIntHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomWeightedIntArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_weighted_int", err), nil
+	}
+
+	value, probability, err := randomWeightedInt(ctx, args.Values, args.Weights)
+	if err != nil {
+		return toolErrorResult("random_weighted_int", err), nil
+	}
+
+	response := randomWeightedIntResponse{Value: value, Probability: probability, SchemaVersion: schemaVersions["random_weighted_int"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strconv.FormatInt(value, 10)},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomWeightedInt returns one of values selected in proportion to the parallel weights,
+// along with the probability of drawing the selected value. Values may repeat, in which case
+// the probability sums the weights of every entry holding that value. Weights must have one
+// non-negative, finite entry per value and a positive sum.
+func randomWeightedInt(ctx context.Context, values []int64, weights []float64) (int64, float64, error) {
+	if len(values) == 0 {
+		return 0, 0, fmt.Errorf("values must not be empty")
+	}
+	if len(weights) != len(values) {
+		return 0, 0, fmt.Errorf("weights length %d must equal values length %d", len(weights), len(values))
+	}
+
+	index, _, err := randomWeightedIndex(ctx, len(values), weights)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var shared, total float64
+	for i, weight := range weights {
+		total += weight
+		if values[i] == values[index] {
+			shared += weight
+		}
+	}
+	return values[index], shared / total, nil
+}
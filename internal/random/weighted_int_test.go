@@ -0,0 +1,132 @@
+package random
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRandomWeightedIntHandler(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		request     mcp.CallToolRequest
+		allowed     []int64
+		probability float64
+		wantErr     bool
+	}{
+		{
+			desc:        "valid request with equal weights",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"values": []any{10, 20}, "weights": []any{1.0, 1.0}}}},
+			allowed:     []int64{10, 20},
+			probability: 0.5,
+		},
+		{
+			desc:        "valid request with zero weights skipped",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"values": []any{1, -5, 3}, "weights": []any{0.0, 2.0, 0.0}}}},
+			allowed:     []int64{-5},
+			probability: 1,
+		},
+		{
+			desc:        "valid request with duplicate values",
+			request:     mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"values": []any{7, 8, 7}, "weights": []any{1.0, 2.0, 1.0}}}},
+			allowed:     []int64{7, 8},
+			probability: 0.5,
+		},
+		{
+			desc:    "invalid request with no args",
+			request: mcp.CallToolRequest{},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with mismatched lengths",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"values": []any{1, 2}, "weights": []any{1.0}}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with negative weight",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"values": []any{1, 2}, "weights": []any{1.0, -1.0}}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with zero total weight",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"values": []any{1, 2}, "weights": []any{0.0, 0.0}}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomWeightedIntHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomWeightedIntHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomWeightedIntHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomWeightedIntHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomWeightedIntHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomWeightedIntHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			valueFromText, err := strconv.ParseInt(textContent.Text, 10, 64)
+			if err != nil {
+				t.Fatalf("randomWeightedIntHandler() invalid text content: %v", err)
+			}
+
+			structured, ok := result.StructuredContent.(randomWeightedIntResponse)
+			if !ok {
+				t.Fatalf("randomWeightedIntHandler() structured content type = %T, want randomWeightedIntResponse", result.StructuredContent)
+			}
+			if structured.Value != valueFromText {
+				t.Fatalf("randomWeightedIntHandler() structured value %d != text value %d", structured.Value, valueFromText)
+			}
+			found := false
+			for _, allowed := range tc.allowed {
+				if structured.Value == allowed {
+					found = true
+				}
+			}
+			if !found {
+				t.Fatalf("randomWeightedIntHandler() value %d not in %v", structured.Value, tc.allowed)
+			}
+			if math.Abs(structured.Probability-tc.probability) > 1e-12 {
+				t.Fatalf("randomWeightedIntHandler() probability = %g, want %g", structured.Probability, tc.probability)
+			}
+		})
+	}
+}
+
+func TestRandomWeightedIntDistribution(t *testing.T) {
+	values := []int64{1, 2, 3}
+	weights := []float64{1, 3, 6}
+	const samples = 20000
+
+	counts := make(map[int64]int)
+	for range samples {
+		value, _, err := randomWeightedInt(t.Context(), values, weights)
+		if err != nil {
+			t.Fatalf("randomWeightedInt() error = %v", err)
+		}
+		counts[value]++
+	}
+
+	for i, value := range values {
+		want := weights[i] / 10
+		got := float64(counts[value]) / samples
+		if math.Abs(got-want) > 0.02 {
+			t.Fatalf("randomWeightedInt() frequency of %d = %.3f, want about %.2f", value, got, want)
+		}
+	}
+}
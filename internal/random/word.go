@@ -0,0 +1,76 @@
+package random
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxWordCount caps the number of words random_word will return in a single call.
+const maxWordCount = 1000
+
+// wordListData is the EFF large wordlist (https://www.eff.org/dice), 7776 common English
+// words, licensed under CC BY 3.0 US.
+//
+//go:embed wordlist.txt
+var wordListData string
+
+// wordList holds the embedded words in their original order.
+var wordList = strings.Fields(wordListData)
+
+type randomWordResponse struct {
+	Values        []string `json:"values"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Algorithm     string   `json:"algorithm"`
+}
+
+type randomWordArgs struct {
+	Count *int `json:"count,omitempty"`
+}
+
+func randomWordHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args randomWordArgs
+	if err := request.BindArguments(&args); err != nil {
+		return toolErrorResult("random_word", err), nil
+	}
+
+	count := 1
+	if args.Count != nil {
+		count = *args.Count
+	}
+	if count <= 0 || count > maxWordCount {
+		return toolErrorResult("random_word", fmt.Errorf("count must be between 1 and %d", maxWordCount)), nil
+	}
+
+	values, err := randomWords(ctx, count)
+	if err != nil {
+		return toolErrorResult("random_word", err), nil
+	}
+
+	response := randomWordResponse{Values: values, SchemaVersion: schemaVersions["random_word"], Algorithm: algorithmCryptoRand}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.TextContent{Type: "text", Text: strings.Join(values, "\n")},
+		},
+		StructuredContent: response,
+	}, nil
+}
+
+// randomWords returns count words drawn independently and uniformly from wordList.
+func randomWords(ctx context.Context, count int) ([]string, error) {
+	values := make([]string, count)
+	for i := range values {
+		if err := checkCancelled(ctx, i); err != nil {
+			return nil, err
+		}
+		index, err := sourceFromContext(ctx).Int64(0, int64(len(wordList)-1))
+		if err != nil {
+			return nil, err
+		}
+		values[i] = wordList[index]
+	}
+	return values, nil
+}
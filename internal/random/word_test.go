@@ -0,0 +1,94 @@
+package random
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestWordList(t *testing.T) {
+	if len(wordList) != 7776 {
+		t.Fatalf("len(wordList) = %d, want 7776", len(wordList))
+	}
+	seen := make(map[string]bool, len(wordList))
+	for _, word := range wordList {
+		if seen[word] {
+			t.Fatalf("wordList contains duplicate %q", word)
+		}
+		seen[word] = true
+	}
+}
+
+func TestRandomWordHandler(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		request mcp.CallToolRequest
+		count   int
+		wantErr bool
+	}{
+		{
+			desc:    "valid request with no args",
+			request: mcp.CallToolRequest{},
+			count:   1,
+		},
+		{
+			desc:    "valid request with count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 6}}},
+			count:   6,
+		},
+		{
+			desc:    "invalid request with zero count",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": 0}}},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid request with count over cap",
+			request: mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"count": maxWordCount + 1}}},
+			wantErr: true,
+		},
+	}
+
+	ctx := t.Context()
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := randomWordHandler(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("randomWordHandler() error = %v", err)
+			}
+			if result == nil || len(result.Content) == 0 {
+				t.Fatalf("randomWordHandler() result is nil or empty")
+			}
+			if tc.wantErr {
+				if !result.IsError {
+					t.Fatalf("randomWordHandler() expected error, got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("randomWordHandler() returned error content: %+v", result.Content[0])
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("randomWordHandler() content type = %T, want TextContent", result.Content[0])
+			}
+			structured, ok := result.StructuredContent.(randomWordResponse)
+			if !ok {
+				t.Fatalf("randomWordHandler() structured content type = %T, want randomWordResponse", result.StructuredContent)
+			}
+			if len(structured.Values) != tc.count {
+				t.Fatalf("randomWordHandler() returned %d words, want %d", len(structured.Values), tc.count)
+			}
+			if textContent.Text != strings.Join(structured.Values, "\n") {
+				t.Fatalf("randomWordHandler() text %q does not match values %v", textContent.Text, structured.Values)
+			}
+			for _, word := range structured.Values {
+				if !slices.Contains(wordList, word) {
+					t.Fatalf("randomWordHandler() word %q is not in the word list", word)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package securerand
+
+import "math"
+
+// ZeroLengthError is returned when a generator is asked for a non-positive length.
+type ZeroLengthError struct {
+}
+
+func (e *ZeroLengthError) Error() string {
+	return "length cannot be zero"
+}
+
+// RangeError is returned when the lower bound of a range is greater than the upper bound.
+type RangeError struct {
+}
+
+func (e *RangeError) Error() string {
+	return "min cannot be greater than max"
+}
+
+// ExcludedBoundaryError is returned when excluding a bound leaves no value to draw from.
+type ExcludedBoundaryError struct {
+	Min float64
+	Max float64
+}
+
+func (e *ExcludedBoundaryError) Error() string {
+	if e.Min == e.Max {
+		return "range is empty when min equals max and is excluded"
+	}
+	return "range is empty after applying exclusivity"
+}
+
+// NonFiniteError is returned when a bound is NaN or infinite.
+type NonFiniteError struct {
+	Value float64
+}
+
+func (e *NonFiniteError) Error() string {
+	if math.IsNaN(e.Value) {
+		return "min and max must not be NaN"
+	}
+	return "min and max must be finite"
+}
+
+// EntropyError is returned when the entropy source fails or runs out before supplying the
+// bytes a value needs. Err is the reader's error.
+type EntropyError struct {
+	Err error
+}
+
+func (e *EntropyError) Error() string {
+	return "entropy source failed: " + e.Err.Error()
+}
+
+func (e *EntropyError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,115 @@
+package securerand
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestTypedErrors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		call     func() error
+		check    func(error) bool
+		wantText string
+	}{
+		{
+			desc: "Int64 min greater than max",
+			call: func() error { _, err := Int64(5, 1); return err },
+			check: func(err error) bool {
+				var target *RangeError
+				return errors.As(err, &target)
+			},
+			wantText: "min cannot be greater than max",
+		},
+		{
+			desc: "Float64 min greater than max",
+			call: func() error { _, err := Float64(5, 1, true, true); return err },
+			check: func(err error) bool {
+				var target *RangeError
+				return errors.As(err, &target)
+			},
+			wantText: "min cannot be greater than max",
+		},
+		{
+			desc: "Float64 equal bounds excluded",
+			call: func() error { _, err := Float64(1, 1, false, true); return err },
+			check: func(err error) bool {
+				var target *ExcludedBoundaryError
+				return errors.As(err, &target) && target.Min == 1 && target.Max == 1
+			},
+			wantText: "range is empty when min equals max and is excluded",
+		},
+		{
+			desc: "Float64 adjacent bounds excluded",
+			call: func() error { _, err := Float64(1, math.Nextafter(1, 2), false, false); return err },
+			check: func(err error) bool {
+				var target *ExcludedBoundaryError
+				return errors.As(err, &target)
+			},
+			wantText: "range is empty after applying exclusivity",
+		},
+		{
+			desc: "Float64 NaN bound",
+			call: func() error { _, err := Float64(0, math.NaN(), true, true); return err },
+			check: func(err error) bool {
+				var target *NonFiniteError
+				return errors.As(err, &target) && math.IsNaN(target.Value)
+			},
+			wantText: "min and max must not be NaN",
+		},
+		{
+			desc: "Float64 infinite bound",
+			call: func() error { _, err := Float64(math.Inf(-1), 0, true, true); return err },
+			check: func(err error) bool {
+				var target *NonFiniteError
+				return errors.As(err, &target) && math.IsInf(target.Value, -1)
+			},
+			wantText: "min and max must be finite",
+		},
+		{
+			desc: "ASCII zero length",
+			call: func() error { _, err := ASCII(0); return err },
+			check: func(err error) bool {
+				var target *ZeroLengthError
+				return errors.As(err, &target)
+			},
+			wantText: "length cannot be zero",
+		},
+		{
+			desc: "Int64 from an empty source",
+			call: func() error { _, err := New(bytes.NewReader(nil)).Int64(1, 10); return err },
+			check: func(err error) bool {
+				var target *EntropyError
+				return errors.As(err, &target) && errors.Is(err, io.EOF)
+			},
+			wantText: "entropy source failed: EOF",
+		},
+		{
+			desc: "UnitFloat64 from a short source",
+			call: func() error { _, err := New(bytes.NewReader([]byte{1, 2, 3})).UnitFloat64(); return err },
+			check: func(err error) bool {
+				var target *EntropyError
+				return errors.As(err, &target) && errors.Is(err, io.ErrUnexpectedEOF)
+			},
+			wantText: "entropy source failed: unexpected EOF",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.call()
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tc.check(err) {
+				t.Fatalf("error %T (%v) does not match the expected type", err, err)
+			}
+			if err.Error() != tc.wantText {
+				t.Fatalf("error text = %q, want %q", err.Error(), tc.wantText)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Package securerand generates cryptographically secure random values backed by crypto/rand.
+// It is the library behind the go-random-number-mcp tools and can be used without MCP.
+//
+// The package-level functions read from crypto/rand.Reader. Use New to draw from another
+// entropy source, such as a fixed stream in tests.
+package securerand
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/kevensen/go-random-number-mcp/internal/bufpool"
+)
+
+const (
+	asciiStart = 32
+	asciiEnd   = 126
+	asciiRange = asciiEnd - asciiStart + 1
+	// asciiRejectThreshold is the largest multiple of asciiRange that fits in a byte.
+	asciiRejectThreshold = asciiRange * (256 / asciiRange)
+)
+
+// Rand generates values from the bytes of an entropy source. Its methods are safe for
+// concurrent use when the underlying reader is.
+type Rand struct {
+	reader io.Reader
+}
+
+// New returns a Rand that reads from reader. Its output is only as unpredictable as reader;
+// pass crypto/rand.Reader, or use the package-level functions, for secrets.
+func New(reader io.Reader) *Rand {
+	return &Rand{reader: reader}
+}
+
+// defaultRand backs the package-level functions.
+var defaultRand = New(rand.Reader)
+
+// Read fills p entirely from the entropy source, so a short read is always reported as an error.
+// Every method draws its bytes through Read, so any failure of the source surfaces as an
+// *EntropyError.
+func (r *Rand) Read(p []byte) (int, error) {
+	n, err := io.ReadFull(r.reader, p)
+	if err != nil {
+		return n, &EntropyError{Err: err}
+	}
+	return n, nil
+}
+
+// Int64 returns a cryptographically secure random integer in the inclusive range [min, max].
+// Every value in the range is equally likely, for any bounds up to and including the full
+// span [math.MinInt64, math.MaxInt64]: the range size is computed in big.Int arithmetic, so it
+// never overflows, and the draw is rejection sampled, so it is unbiased.
+// It returns a *RangeError when min is greater than max.
+func Int64(min, max int64) (int64, error) {
+	return defaultRand.Int64(min, max)
+}
+
+// Int64 returns a random integer in the inclusive range [min, max] drawn from r.
+// It returns a *RangeError when min is greater than max.
+func (r *Rand) Int64(min, max int64) (int64, error) {
+	minBig := big.NewInt(min)
+	maxBig := big.NewInt(max)
+	if minBig.Cmp(maxBig) > 0 {
+		return 0, &RangeError{}
+	}
+
+	// The full int64 span has 2^64 values, one more than fits in an int64 or uint64.
+	rangeSize := new(big.Int).Sub(maxBig, minBig)
+	rangeSize.Add(rangeSize, big.NewInt(1))
+	value, err := rand.Int(r, rangeSize)
+	if err != nil {
+		return 0, err
+	}
+
+	value.Add(value, minBig)
+	return value.Int64(), nil
+}
+
+// Float64 returns a cryptographically secure random float in [min, max], excluding a bound
+// when its include flag is false. The value is an affine map of a 53-bit uniform from
+// UnitFloat64, so it is uniform over the range at a resolution of span/2^53; for very wide
+// ranges not every representable float64 near the lower bound can be produced.
+//
+// It returns a *NonFiniteError for NaN or infinite bounds, a *RangeError when min is greater
+// than max, and an *ExcludedBoundaryError when exclusivity leaves the range empty.
+func Float64(min, max float64, includeMin, includeMax bool) (float64, error) {
+	return defaultRand.Float64(min, max, includeMin, includeMax)
+}
+
+// Float64 is like the package-level Float64 but draws from r.
+func (r *Rand) Float64(min, max float64, includeMin, includeMax bool) (float64, error) {
+	if math.IsNaN(min) {
+		return 0, &NonFiniteError{Value: min}
+	}
+	if math.IsNaN(max) {
+		return 0, &NonFiniteError{Value: max}
+	}
+	if math.IsInf(min, 0) {
+		return 0, &NonFiniteError{Value: min}
+	}
+	if math.IsInf(max, 0) {
+		return 0, &NonFiniteError{Value: max}
+	}
+	if min > max {
+		return 0, &RangeError{}
+	}
+	if min == max {
+		if includeMin && includeMax {
+			return min, nil
+		}
+		return 0, &ExcludedBoundaryError{Min: min, Max: max}
+	}
+
+	lo, hi := min, max
+	if !includeMin {
+		lo = math.Nextafter(min, math.Inf(1))
+	}
+	if !includeMax {
+		hi = math.Nextafter(max, math.Inf(-1))
+	}
+	if lo > hi {
+		return 0, &ExcludedBoundaryError{Min: min, Max: max}
+	}
+
+	unit, err := r.UnitFloat64()
+	if err != nil {
+		return 0, err
+	}
+
+	return interpolate(lo, hi, unit), nil
+}
+
+// interpolate maps unit in [0, 1) onto [lo, hi] without overflowing when the
+// span hi-lo exceeds math.MaxFloat64, and clamps away rounding error at the edges.
+func interpolate(lo, hi, unit float64) float64 {
+	var value float64
+	if span := hi - lo; !math.IsInf(span, 0) {
+		value = lo + unit*span
+	} else {
+		// Both bounds are huge and of opposite sign, so halving them loses no meaningful precision.
+		value = 2 * (lo/2 + unit*(hi/2-lo/2))
+	}
+	return math.Min(math.Max(value, lo), hi)
+}
+
+// UnitFloat64 returns a cryptographically secure float uniformly distributed on the
+// grid k/2^53 for k in [0, 2^53), which is every float64 in [0, 1) with a full 53-bit mantissa.
+func UnitFloat64() (float64, error) {
+	return defaultRand.UnitFloat64()
+}
+
+// UnitFloat64 is like the package-level UnitFloat64 but draws from r. It reads 8 bytes and
+// keeps the top 53 bits, avoiding the big.Int values rand.Int would allocate on every call.
+func (r *Rand) UnitFloat64() (float64, error) {
+	const maxUint53 = 1 << 53
+	buf := bufpool.Get(8)
+	defer bufpool.Put(buf)
+	if _, err := r.Read(*buf); err != nil {
+		return 0, err
+	}
+	return float64(binary.BigEndian.Uint64(*buf)>>11) / maxUint53, nil
+}
+
+// ASCII returns a cryptographically secure random string of printable ASCII characters
+// (32 through 126). Length must be greater than zero; otherwise a *ZeroLengthError is returned.
+func ASCII(length int) (string, error) {
+	return defaultRand.ASCII(length)
+}
+
+// ASCII is like the package-level ASCII but draws from r.
+func (r *Rand) ASCII(length int) (string, error) {
+	if length <= 0 {
+		return "", &ZeroLengthError{}
+	}
+
+	out := make([]byte, 0, length)
+	buf := bufpool.Get(asciiBufferSize(length))
+	defer bufpool.Put(buf)
+	for len(out) < length {
+		// Only top up what is still missing; the first read almost always covers the whole string.
+		chunk := (*buf)[:asciiBufferSize(length-len(out))]
+		if _, err := r.Read(chunk); err != nil {
+			return "", err
+		}
+		for _, b := range chunk {
+			// Bytes at or above the threshold would make the low residues more likely.
+			if b >= asciiRejectThreshold {
+				continue
+			}
+			out = append(out, asciiStart+b%asciiRange)
+			if len(out) == length {
+				break
+			}
+		}
+	}
+
+	return string(out), nil
+}
+
+// asciiBufferSize returns how many random bytes to read for n characters. It oversamples by
+// the expected rejection rate (256/190) plus a margin so a single read usually suffices.
+func asciiBufferSize(n int) int {
+	return n*256/asciiRejectThreshold + n/16 + 16
+}
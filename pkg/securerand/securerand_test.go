@@ -0,0 +1,444 @@
+package securerand
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestInt64(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		min     int64
+		max     int64
+		wantErr bool
+	}{
+		{desc: "single value", min: 7, max: 7},
+		{desc: "small range", min: -3, max: 3},
+		{desc: "full int64 range", min: math.MinInt64, max: math.MaxInt64},
+		{desc: "full int64 range without max", min: math.MinInt64, max: math.MaxInt64 - 1},
+		{desc: "full int64 range without min", min: math.MinInt64 + 1, max: math.MaxInt64},
+		{desc: "non-negative int64 range", min: 0, max: math.MaxInt64},
+		{desc: "negative int64 range", min: math.MinInt64, max: -1},
+		{desc: "min greater than max", min: 2, max: 1, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				value, err := Int64(tc.min, tc.max)
+				if tc.wantErr {
+					if err == nil {
+						t.Fatalf("Int64(%d, %d) expected error, got %d", tc.min, tc.max, value)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Int64(%d, %d) error = %v", tc.min, tc.max, err)
+				}
+				if value < tc.min || value > tc.max {
+					t.Fatalf("Int64(%d, %d) = %d, out of range", tc.min, tc.max, value)
+				}
+			}
+		})
+	}
+}
+
+func TestInt64FullRangeKnownValues(t *testing.T) {
+	ones := bytes.Repeat([]byte{0xff}, 8)
+	zeros := make([]byte, 8)
+	testCases := []struct {
+		desc   string
+		min    int64
+		max    int64
+		source []byte
+		want   int64
+	}{
+		{desc: "full range lowest draw", min: math.MinInt64, max: math.MaxInt64, source: zeros, want: math.MinInt64},
+		{desc: "full range highest draw", min: math.MinInt64, max: math.MaxInt64, source: ones, want: math.MaxInt64},
+		{desc: "full range midpoint", min: math.MinInt64, max: math.MaxInt64, source: []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, want: 0},
+		{desc: "near full range highest draw", min: math.MinInt64 + 1, max: math.MaxInt64, source: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}, want: math.MaxInt64},
+		// 2^64-1 values fit in 64 bits with one pattern to spare; that pattern is rejected and redrawn.
+		{desc: "near full range rejects the spare draw", min: math.MinInt64 + 1, max: math.MaxInt64, source: append(ones, zeros...), want: math.MinInt64 + 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			value, err := New(bytes.NewReader(tc.source)).Int64(tc.min, tc.max)
+			if err != nil {
+				t.Fatalf("Int64(%d, %d) error = %v", tc.min, tc.max, err)
+			}
+			if value != tc.want {
+				t.Fatalf("Int64(%d, %d) = %d, want %d", tc.min, tc.max, value, tc.want)
+			}
+		})
+	}
+}
+
+func TestInt64FullRangeIsUniform(t *testing.T) {
+	// Over the full span every bit of the result should be set half the time; a bias from
+	// overflow or modulo reduction would skew the high bits first.
+	const samples = 20000
+	var counts [64]int
+	for i := 0; i < samples; i++ {
+		value, err := Int64(math.MinInt64, math.MaxInt64)
+		if err != nil {
+			t.Fatalf("Int64() error = %v", err)
+		}
+		for bit := range counts {
+			if uint64(value)&(1<<bit) != 0 {
+				counts[bit]++
+			}
+		}
+	}
+
+	for bit, count := range counts {
+		if share := float64(count) / samples; math.Abs(share-0.5) > 0.03 {
+			t.Fatalf("Int64() bit %d set in %.4f of draws, want about 0.5", bit, share)
+		}
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		min        float64
+		max        float64
+		includeMin bool
+		includeMax bool
+		wantErr    bool
+	}{
+		{desc: "inclusive range", min: -1, max: 1, includeMin: true, includeMax: true},
+		{desc: "exclusive range", min: 0, max: 1},
+		{desc: "equal inclusive bounds", min: 2.5, max: 2.5, includeMin: true, includeMax: true},
+		{desc: "equal bounds with exclusion", min: 2.5, max: 2.5, includeMin: true, wantErr: true},
+		{desc: "adjacent floats both excluded", min: 1, max: math.Nextafter(1, 2), wantErr: true},
+		{desc: "min greater than max", min: 1, max: 0, includeMin: true, includeMax: true, wantErr: true},
+		{desc: "NaN bound", min: math.NaN(), max: 1, includeMin: true, includeMax: true, wantErr: true},
+		{desc: "infinite bound", min: 0, max: math.Inf(1), includeMin: true, includeMax: true, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			value, err := Float64(tc.min, tc.max, tc.includeMin, tc.includeMax)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Float64() expected error, got %v", value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Float64() error = %v", err)
+			}
+			if value < tc.min || value > tc.max {
+				t.Fatalf("Float64() = %v, out of [%v, %v]", value, tc.min, tc.max)
+			}
+			if (!tc.includeMin && value == tc.min) || (!tc.includeMax && value == tc.max) {
+				t.Fatalf("Float64() = %v, returned an excluded bound", value)
+			}
+		})
+	}
+}
+
+func TestFloat64StaysFinite(t *testing.T) {
+	testCases := []struct {
+		desc string
+		min  float64
+		max  float64
+	}{
+		{desc: "default range", min: 0, max: math.MaxFloat64},
+		{desc: "full float64 span", min: -math.MaxFloat64, max: math.MaxFloat64},
+		{desc: "negative half", min: -math.MaxFloat64, max: 0},
+		{desc: "wide asymmetric span", min: -math.MaxFloat64 / 2, max: math.MaxFloat64},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for i := 0; i < 1000; i++ {
+				value, err := Float64(tc.min, tc.max, true, true)
+				if err != nil {
+					t.Fatalf("Float64() error = %v", err)
+				}
+				if math.IsInf(value, 0) || math.IsNaN(value) {
+					t.Fatalf("Float64() value is not finite: %v", value)
+				}
+				if value < tc.min || value > tc.max {
+					t.Fatalf("Float64() value out of range: %v", value)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	testCases := []struct {
+		lo   float64
+		hi   float64
+		unit float64
+		want float64
+	}{
+		{lo: 0, hi: 10, unit: 0, want: 0},
+		{lo: 0, hi: 10, unit: 0.5, want: 5},
+		{lo: -math.MaxFloat64, hi: math.MaxFloat64, unit: 0.5, want: 0},
+		{lo: -math.MaxFloat64, hi: math.MaxFloat64, unit: 0, want: -math.MaxFloat64},
+		{lo: -math.MaxFloat64, hi: math.MaxFloat64, unit: math.Nextafter(1, 0), want: math.MaxFloat64 * (1 - 0x1p-52)},
+	}
+	for _, tc := range testCases {
+		if got := interpolate(tc.lo, tc.hi, tc.unit); got != tc.want {
+			t.Fatalf("interpolate(%v, %v, %v) = %v, want %v", tc.lo, tc.hi, tc.unit, got, tc.want)
+		}
+	}
+}
+
+func TestUnitFloat64IsUniform(t *testing.T) {
+	const samples = 100000
+	const buckets = 10
+
+	var sum, sumSquares float64
+	counts := make([]int, buckets)
+	for i := 0; i < samples; i++ {
+		value, err := UnitFloat64()
+		if err != nil {
+			t.Fatalf("UnitFloat64() error = %v", err)
+		}
+		if value < 0 || value >= 1 {
+			t.Fatalf("UnitFloat64() value out of [0, 1): %v", value)
+		}
+		sum += value
+		sumSquares += value * value
+		counts[int(value*buckets)]++
+	}
+
+	// A uniform [0, 1) variable has mean 1/2 and variance 1/12. The tolerances are roughly
+	// six standard errors for this sample size, so a correct generator essentially never fails.
+	mean := sum / samples
+	variance := sumSquares/samples - mean*mean
+	if math.Abs(mean-0.5) > 0.006 {
+		t.Fatalf("UnitFloat64() sample mean = %f, want 0.5", mean)
+	}
+	if math.Abs(variance-1.0/12) > 0.003 {
+		t.Fatalf("UnitFloat64() sample variance = %f, want %f", variance, 1.0/12)
+	}
+
+	expected := float64(samples) / buckets
+	for i, count := range counts {
+		if math.Abs(float64(count)-expected) > 6*math.Sqrt(expected) {
+			t.Fatalf("UnitFloat64() bucket %d has %d samples, want about %.0f", i, count, expected)
+		}
+	}
+}
+
+func TestASCII(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		length  int
+		wantErr bool
+	}{
+		{desc: "zero length", length: 0, wantErr: true},
+		{desc: "negative length", length: -1, wantErr: true},
+		{desc: "single character", length: 1},
+		{desc: "long string", length: 4096},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			value, err := ASCII(tc.length)
+			if tc.wantErr {
+				var zeroLengthErr *ZeroLengthError
+				if !errors.As(err, &zeroLengthErr) {
+					t.Fatalf("ASCII(%d) error = %v, want *ZeroLengthError", tc.length, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ASCII(%d) error = %v", tc.length, err)
+			}
+			if len(value) != tc.length {
+				t.Fatalf("ASCII(%d) length = %d", tc.length, len(value))
+			}
+			for i := 0; i < len(value); i++ {
+				if value[i] < asciiStart || value[i] > asciiEnd {
+					t.Fatalf("ASCII(%d) non-printable character %d at index %d", tc.length, value[i], i)
+				}
+			}
+		})
+	}
+}
+
+func TestASCIICoversCharset(t *testing.T) {
+	value, err := ASCII(20000)
+	if err != nil {
+		t.Fatalf("ASCII() error = %v", err)
+	}
+	seen := map[byte]bool{}
+	for i := 0; i < len(value); i++ {
+		seen[value[i]] = true
+	}
+	if len(seen) != asciiRange {
+		t.Fatalf("ASCII() produced %d distinct characters, want %d", len(seen), asciiRange)
+	}
+}
+
+func TestASCIIRejectThreshold(t *testing.T) {
+	// 95 * floor(256/95) = 190: bytes 190-255 are rejected so every residue mod 95 is equally likely.
+	if asciiRejectThreshold != 190 {
+		t.Fatalf("asciiRejectThreshold = %d, want 190", asciiRejectThreshold)
+	}
+	if asciiRejectThreshold%asciiRange != 0 || asciiRejectThreshold+asciiRange <= 255 {
+		t.Fatalf("asciiRejectThreshold = %d is not the largest multiple of %d below 256", asciiRejectThreshold, asciiRange)
+	}
+}
+
+// bigIntASCII is the previous per-character implementation, kept as a benchmark baseline.
+func TestNewReadsFromSource(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		entropy []byte
+		draw    func(r *Rand) (any, error)
+		want    any
+	}{
+		{
+			desc:    "Int64 uses the byte as the offset",
+			entropy: []byte{0xab},
+			draw:    func(r *Rand) (any, error) { return r.Int64(0, 255) },
+			want:    int64(0xab),
+		},
+		{
+			desc:    "Int64 rejects offsets outside the range",
+			entropy: []byte{0x07, 0x05, 0x02},
+			draw:    func(r *Rand) (any, error) { return r.Int64(10, 14) },
+			want:    int64(12),
+		},
+		{
+			desc:    "UnitFloat64 of zero bytes",
+			entropy: make([]byte, 8),
+			draw:    func(r *Rand) (any, error) { return r.UnitFloat64() },
+			want:    0.0,
+		},
+		{
+			desc:    "UnitFloat64 uses the top 53 bits",
+			entropy: []byte{0x80, 0, 0, 0, 0, 0, 0x07, 0xff},
+			draw:    func(r *Rand) (any, error) { return r.UnitFloat64() },
+			want:    0.5,
+		},
+		{
+			desc:    "UnitFloat64 of all one bits stays below 1",
+			entropy: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			draw:    func(r *Rand) (any, error) { return r.UnitFloat64() },
+			want:    1 - 1.0/(1<<53),
+		},
+		{
+			desc:    "ASCII maps bytes onto printable characters",
+			entropy: []byte{0, 33, 94, 200, 1},
+			draw:    func(r *Rand) (any, error) { return r.ASCII(4) },
+			want:    " A~!",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Pad the entropy so ASCII's oversized first read succeeds.
+			entropy := append(tc.entropy, make([]byte, 64)...)
+			got, err := tc.draw(New(bytes.NewReader(entropy)))
+			if err != nil {
+				t.Fatalf("draw error = %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("draw = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewReportsExhaustedSource(t *testing.T) {
+	r := New(bytes.NewReader([]byte{0x01}))
+	if _, err := r.Int64(0, 1<<20); err == nil {
+		t.Fatalf("Int64() from a one-byte source expected error, got nil")
+	}
+	if _, err := r.Read(make([]byte, 4)); err == nil {
+		t.Fatalf("Read() from an exhausted source expected error, got nil")
+	}
+}
+
+func bigIntASCII(length int) (string, error) {
+	var builder strings.Builder
+	builder.Grow(length)
+	max := big.NewInt(asciiRange)
+	for i := 0; i < length; i++ {
+		value, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		builder.WriteByte(byte(asciiStart + value.Int64()))
+	}
+	return builder.String(), nil
+}
+
+func BenchmarkASCII(b *testing.B) {
+	implementations := []struct {
+		name     string
+		generate func(int) (string, error)
+	}{
+		{name: "bigint", generate: bigIntASCII},
+		{name: "buffered", generate: ASCII},
+	}
+
+	for _, length := range []int{64, 4096, 1 << 16} {
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s/length=%d", impl.name, length), func(b *testing.B) {
+				for b.Loop() {
+					if _, err := impl.generate(length); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// bigIntUnitFloat64 is the previous rand.Int based implementation, kept as a benchmark baseline.
+func bigIntUnitFloat64() (float64, error) {
+	const maxUint53 = 1 << 53
+	value, err := rand.Int(rand.Reader, big.NewInt(maxUint53))
+	if err != nil {
+		return 0, err
+	}
+	return float64(value.Int64()) / float64(maxUint53), nil
+}
+
+func BenchmarkUnitFloat64(b *testing.B) {
+	implementations := []struct {
+		name     string
+		generate func() (float64, error)
+	}{
+		{name: "bigint", generate: bigIntUnitFloat64},
+		{name: "buffered", generate: UnitFloat64},
+	}
+
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := impl.generate(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestASCIIDoesNotLeakPooledEntropy(t *testing.T) {
+	// Draw a long string first so its buffer lands in the pool, then check that a short draw
+	// from an exhausted source fails instead of reusing the leftover bytes.
+	if _, err := New(bytes.NewReader(bytes.Repeat([]byte{1}, 4096))).ASCII(1024); err != nil {
+		t.Fatalf("ASCII() error = %v", err)
+	}
+	if _, err := New(bytes.NewReader(nil)).ASCII(8); err == nil {
+		t.Fatalf("ASCII() from an empty source expected error, got nil")
+	}
+}